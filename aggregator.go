@@ -0,0 +1,187 @@
+package exql
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// hasManyTag is the exql tag for the field holding child models of the one-to-many relation.
+const hasManyTag = "has_many"
+
+type aggregateSchema struct {
+	primaryFields []int
+	children      []*aggregateChild
+}
+
+type aggregateChild struct {
+	field         int
+	elemType      reflect.Type // Child
+	primaryFields []int
+}
+
+// AggregateRows reads all joined rows and aggregates them into parents with their children.
+// Parents are deduplicated by their primary key, and children are appended into
+// the fields of the parent tagged with `exql:"has_many:<table>"`.
+// Each row is mapped by m into the parent and children in the order of fields,
+// so the columns in the rows must be ordered as well if m is made by NewSerialMapper.
+// Children of the outer-joined NULL columns are ignored, resulting in empty slices.
+// It closes rows after mapping regardless error occurred.
+//
+// Example:
+//
+//	type UserWithGroups struct {
+//		Id     int64               `exql:"column:id;primary"`
+//		Name   string              `exql:"column:name"`
+//		Groups []*model.UserGroups `exql:"has_many:user_groups"`
+//	}
+//	// SELECT users.*, user_groups.* FROM users LEFT JOIN ...
+//	users, err := exql.AggregateRows[UserWithGroups](rows, m)
+func AggregateRows[P any](rows SqlRows, m SerialMapper) ([]*P, error) {
+	defer rows.Close()
+
+	parentType := reflect.TypeFor[P]()
+	schema, err := parseAggregateSchema(parentType)
+	if err != nil {
+		return nil, err
+	}
+	var parents []*P
+	parentIndexes := map[string]int{}
+	// Keys of children that are already appended for each parent and field
+	childKeys := map[string]struct{}{}
+	for rows.Next() {
+		parent := new(P)
+		dest := []any{parent}
+		childPtrs := make([]reflect.Value, len(schema.children))
+		for i, child := range schema.children {
+			// var child *Child
+			childPtrs[i] = reflect.New(reflect.PointerTo(child.elemType))
+			dest = append(dest, childPtrs[i].Interface())
+		}
+		if err := m.Map(rows, dest...); err != nil {
+			return nil, err
+		}
+		parentValue := reflect.ValueOf(parent).Elem()
+		parentKey := aggregateKey(parentValue, schema.primaryFields)
+		if i, ok := parentIndexes[parentKey]; ok {
+			parentValue = reflect.ValueOf(parents[i]).Elem()
+		} else {
+			parentIndexes[parentKey] = len(parents)
+			parents = append(parents, parent)
+		}
+		for i, child := range schema.children {
+			f := parentValue.Field(child.field)
+			if f.IsNil() {
+				// Children must be empty instead of nil for the parent without children.
+				f.Set(reflect.MakeSlice(f.Type(), 0, 0))
+			}
+			childPtr := childPtrs[i].Elem() // *Child
+			if childPtr.IsNil() {
+				continue
+			}
+			if len(child.primaryFields) > 0 {
+				childKey := strings.Join([]string{
+					parentKey,
+					strconv.Itoa(child.field),
+					aggregateKey(childPtr.Elem(), child.primaryFields),
+				}, "/")
+				if _, ok := childKeys[childKey]; ok {
+					continue
+				}
+				childKeys[childKey] = struct{}{}
+			}
+			f.Set(reflect.Append(f, childPtr))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(parents) == 0 {
+		return nil, ErrRecordNotFound{}
+	}
+	return parents, nil
+}
+
+var errAggregateParent = fmt.Errorf("parent must be a struct with primary fields")
+
+func parseAggregateSchema(t reflect.Type) (*aggregateSchema, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, errAggregateParent
+	}
+	primaryFields, err := parsePrimaryFields(t)
+	if err != nil {
+		return nil, err
+	} else if len(primaryFields) == 0 {
+		return nil, errAggregateParent
+	}
+	var children []*aggregateChild
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("exql")
+		if tag == "" {
+			continue
+		}
+		tags, err := ParseTags(tag)
+		if err != nil {
+			return nil, err
+		}
+		table, ok := tags[hasManyTag]
+		if !ok {
+			continue
+		}
+		// []*Child -> Child
+		if f.Type.Kind() != reflect.Slice ||
+			f.Type.Elem().Kind() != reflect.Pointer ||
+			f.Type.Elem().Elem().Kind() != reflect.Struct {
+			return nil, fmt.Errorf("has_many field must be a slice of pointer of struct: %s", f.Name)
+		}
+		elemType := f.Type.Elem().Elem()
+		if m, ok := reflect.New(elemType).Interface().(Model); ok && table != "" && m.TableName() != table {
+			return nil, fmt.Errorf("has_many table mismatch: expected=%s, actual=%s", table, m.TableName())
+		}
+		childPrimaryFields, err := parsePrimaryFields(elemType)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, &aggregateChild{
+			field:         i,
+			elemType:      elemType,
+			primaryFields: childPrimaryFields,
+		})
+	}
+	if len(children) == 0 {
+		return nil, fmt.Errorf("no has_many fields in parent")
+	}
+	return &aggregateSchema{
+		primaryFields: primaryFields,
+		children:      children,
+	}, nil
+}
+
+// parsePrimaryFields returns indexes of fields tagged as primary.
+func parsePrimaryFields(t reflect.Type) ([]int, error) {
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("exql")
+		if tag == "" {
+			continue
+		}
+		tags, err := ParseTags(tag)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tags["primary"]; ok {
+			fields = append(fields, i)
+		}
+	}
+	return fields, nil
+}
+
+func aggregateKey(v reflect.Value, fields []int) string {
+	var keys []string
+	for _, i := range fields {
+		keys = append(keys, strconv.Quote(fmt.Sprint(v.Field(i).Interface())))
+	}
+	return strings.Join(keys, ",")
+}
@@ -0,0 +1,149 @@
+package exql
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/internal/mock"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type userWithGroups struct {
+	Id     int64               `exql:"column:id;primary"`
+	Name   string              `exql:"column:name"`
+	Groups []*model.UserGroups `exql:"has_many:user_groups"`
+}
+
+func (userWithGroups) TableName() string {
+	return "users"
+}
+
+func TestAggregateRows(t *testing.T) {
+	query := func(t *testing.T, rows *sqlmock.Rows) SqlRows {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		t.Cleanup(func() { mockDb.Close() })
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		res, err := mockDb.Query("SELECT")
+		assert.NoError(t, err)
+		return res
+	}
+	m := NewSerialMapper(func(i int) string { return "id" })
+	t.Run("basic", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{"id", "name", "id", "name"}).
+			AddRow(1, "user1", 1, "group1").
+			AddRow(1, "user1", 2, "group2").
+			AddRow(2, "user2", nil, nil).
+			AddRow(3, "user3", 1, "group1"))
+		users, err := AggregateRows[userWithGroups](rows, m)
+		assert.NoError(t, err)
+		assert.Equal(t, []*userWithGroups{
+			{Id: 1, Name: "user1", Groups: []*model.UserGroups{{Id: 1, Name: "group1"}, {Id: 2, Name: "group2"}}},
+			{Id: 2, Name: "user2", Groups: []*model.UserGroups{}},
+			{Id: 3, Name: "user3", Groups: []*model.UserGroups{{Id: 1, Name: "group1"}}},
+		}, users)
+	})
+	t.Run("should deduplicate children", func(t *testing.T) {
+		type userWithGroupsAndMembers struct {
+			Id      int64               `exql:"column:id;primary"`
+			Groups  []*model.UserGroups `exql:"has_many:user_groups"`
+			Members []*model.GroupUsers `exql:"has_many:group_users"`
+		}
+		rows := query(t, sqlmock.NewRows([]string{"id", "id", "name", "id", "user_id", "group_id"}).
+			AddRow(1, 1, "group1", 10, 1, 1).
+			AddRow(1, 1, "group1", 11, 1, 1).
+			AddRow(1, 2, "group2", 10, 1, 1))
+		users, err := AggregateRows[userWithGroupsAndMembers](rows, m)
+		assert.NoError(t, err)
+		assert.Equal(t, []*userWithGroupsAndMembers{{
+			Id:      1,
+			Groups:  []*model.UserGroups{{Id: 1, Name: "group1"}, {Id: 2, Name: "group2"}},
+			Members: []*model.GroupUsers{{Id: 10, UserId: 1, GroupId: 1}, {Id: 11, UserId: 1, GroupId: 1}},
+		}}, users)
+	})
+	t.Run("with qualified mapper", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{"user_groups__id", "users__id", "users__name"}).
+			AddRow(1, 1, "user1").
+			AddRow(nil, 2, "user2"))
+		users, err := AggregateRows[userWithGroups](rows, NewQualifiedMapper())
+		assert.NoError(t, err)
+		assert.Equal(t, []*userWithGroups{
+			{Id: 1, Name: "user1", Groups: []*model.UserGroups{{Id: 1}}},
+			{Id: 2, Name: "user2", Groups: []*model.UserGroups{}},
+		}, users)
+	})
+	t.Run("should return exql.ErrRecordNotFound if rows is empty", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}}
+		_, err := AggregateRows[userWithGroups](rows, m)
+		assert.ErrorIs(t, err, ErrRecordNotFound{})
+	})
+	t.Run("should return error if mapping failed", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, Values: [][]any{{1}}, ColumnErr: fmt.Errorf("error")}
+		_, err := AggregateRows[userWithGroups](rows, m)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if rows.Err() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, ErrErr: fmt.Errorf("error")}
+		_, err := AggregateRows[userWithGroups](rows, m)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if parent is invalid", func(t *testing.T) {
+		rows := &mock.Rows{}
+		t.Run("not struct", func(t *testing.T) {
+			_, err := AggregateRows[int](rows, m)
+			assert.ErrorIs(t, err, errAggregateParent)
+		})
+		t.Run("no primary fields", func(t *testing.T) {
+			type noPrimary struct {
+				Id     int64               `exql:"column:id"`
+				Groups []*model.UserGroups `exql:"has_many:user_groups"`
+			}
+			_, err := AggregateRows[noPrimary](rows, m)
+			assert.ErrorIs(t, err, errAggregateParent)
+		})
+		t.Run("no has_many fields", func(t *testing.T) {
+			_, err := AggregateRows[partialUser](rows, m)
+			assert.EqualError(t, err, "no has_many fields in parent")
+		})
+		t.Run("bad tag", func(t *testing.T) {
+			type badTag struct {
+				Id     int64               `exql:"column:id;primary"`
+				Groups []*model.UserGroups `exql:"a;a:1"`
+			}
+			_, err := AggregateRows[badTag](rows, m)
+			assert.EqualError(t, err, "duplicated tag: a")
+		})
+		t.Run("not slice of pointer of struct", func(t *testing.T) {
+			type notSlice struct {
+				Id     int64              `exql:"column:id;primary"`
+				Groups []model.UserGroups `exql:"has_many:user_groups"`
+			}
+			_, err := AggregateRows[notSlice](rows, m)
+			assert.EqualError(t, err, "has_many field must be a slice of pointer of struct: Groups")
+		})
+		t.Run("table mismatch", func(t *testing.T) {
+			type mismatch struct {
+				Id     int64               `exql:"column:id;primary"`
+				Groups []*model.UserGroups `exql:"has_many:groups"`
+			}
+			_, err := AggregateRows[mismatch](rows, m)
+			assert.EqualError(t, err, "has_many table mismatch: expected=groups, actual=user_groups")
+		})
+	})
+}
+
+func TestParseSchema_SkipsRelationFields(t *testing.T) {
+	t.Run("map", func(t *testing.T) {
+		s, err := parseMapSchema(reflect.TypeFor[userWithGroups]())
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"id": 0, "name": 1}, s.fields)
+	})
+	t.Run("upsert", func(t *testing.T) {
+		s, err := parseUpsertSchema(reflect.TypeFor[userWithGroups](), false)
+		assert.NoError(t, err)
+		assert.Equal(t, []column{{index: 0, name: "id"}, {index: 1, name: "name"}}, s.columns)
+	})
+}
@@ -0,0 +1,267 @@
+// Command exql-gen generates model files from the database schema.
+//
+// Install:
+//
+//	go install github.com/loilo-inc/exql/v3/cmd/exql-gen@latest
+//
+// Usage:
+//
+//	exql-gen -dsn "root:@tcp(127.0.0.1:3306)/db" -out model -package model
+//	exql-gen -ddl schema/model.sql -out model
+//	exql-gen -config exql-gen.json -dry-run
+//	exql-gen -config exql-gen.json -check
+//
+// Exit codes are 0 on success, 1 on generation failure or drift found by -check, and 2 on invalid usage.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/loilo-inc/exql/v3"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// dsnEnv is the environment variable for DSN, used if neither -dsn nor config sets it.
+const dsnEnv = "EXQL_DSN"
+
+type config struct {
+	// DSN for the database connection.
+	DSN string `json:"dsn"`
+	// DDL is the list of SQL files to read tables from instead of the database.
+	DDL []string `json:"ddl"`
+	// InformationSchema reads tables from information_schema instead of SHOW FULL COLUMNS.
+	InformationSchema bool `json:"information_schema"`
+	exql.GenerateOptions
+}
+
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(v string) error {
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			*l = append(*l, s)
+		}
+	}
+	return nil
+}
+
+type mapFlag map[string]string
+
+func (m mapFlag) String() string {
+	var list []string
+	for k, v := range m {
+		list = append(list, k+"="+v)
+	}
+	return strings.Join(list, ",")
+}
+
+func (m mapFlag) Set(v string) error {
+	k, val, ok := strings.Cut(v, "=")
+	if !ok || k == "" || val == "" {
+		return fmt.Errorf("must be in the form of key=value: %q", v)
+	}
+	m[k] = val
+	return nil
+}
+
+// pairListFlag is the list of key=value pairs in the order of the command line.
+type pairListFlag [][2]string
+
+func (l *pairListFlag) String() string {
+	var list []string
+	for _, p := range *l {
+		list = append(list, p[0]+"="+p[1])
+	}
+	return strings.Join(list, ",")
+}
+
+func (l *pairListFlag) Set(v string) error {
+	k, val, ok := strings.Cut(v, "=")
+	if !ok || k == "" || val == "" {
+		return fmt.Errorf("must be in the form of key=value: %q", v)
+	}
+	*l = append(*l, [2]string{k, val})
+	return nil
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+func run(args []string, stderr io.Writer) int {
+	cfg, err := parseConfig(args, stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	} else if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+	log.SetOutput(stderr)
+	if err := generate(context.Background(), cfg); err != nil {
+		var drift exql.ErrSchemaDrift
+		if errors.As(err, &drift) {
+			fmt.Fprint(stderr, drift.Diff)
+		}
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	return exitOK
+}
+
+func parseConfig(args []string, stderr io.Writer) (*config, error) {
+	fs := flag.NewFlagSet("exql-gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configPath := fs.String("config", "", "path to the JSON config file")
+	dsn := fs.String("dsn", "", "DSN for the database connection (default $"+dsnEnv+")")
+	outDir := fs.String("out", "", `output directory (default "model")`)
+	pkg := fs.String("package", "", `package name of generated files (default "model")`)
+	informationSchema := fs.Bool("information-schema", false, "read tables from information_schema with comments, indexes and foreign keys")
+	dryRun := fs.Bool("dry-run", false, "print generated file names without writing them")
+	repositories := fs.Bool("repositories", false, "generate repository interfaces with implementations and in-memory fakes")
+	updateKeys := fs.Bool("update-keys", false, "keep primary key and auto_increment columns in Update structs")
+	factoryDir := fs.String("factory-dir", "", "directory to generate test data factories of models into")
+	modelImportPath := fs.String("model-import-path", "", "import path of models used by factories (default resolved by go.mod)")
+	schemaSnapshot := fs.String("schema-snapshot", "", "JSON file to write the schema snapshot of all tables into")
+	jsonSchemaDir := fs.String("json-schema-dir", "", "directory to write JSON Schema documents of models into")
+	check := fs.Bool("check", false, "fail with diff if models are out of date, without writing them")
+	var include, exclude, ddl, templates listFlag
+	fs.Var(&templates, "template", "template files generating extra files for each table along with models, comma-separated or repeated")
+	fs.Var(&ddl, "ddl", "SQL files with CREATE TABLE statements to be used instead of the database, comma-separated or repeated")
+	fs.Var(&include, "include", "glob patterns of tables to be generated, comma-separated or repeated")
+	fs.Var(&exclude, "exclude", "glob patterns of tables not to be generated, comma-separated or repeated")
+	fileNames := mapFlag{}
+	fs.Var(fileNames, "file-name", "output file name for the table in the form of table=file.go, repeated")
+	// Type mappings keep the order since the first matching one of the same kind is applied.
+	var types pairListFlag
+	fs.Var(&types, "type", "Go type for the column in the form of table.column=type or glob=type, repeated")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	cfg := &config{}
+	if *configPath != "" {
+		if err := loadConfigFile(*configPath, cfg); err != nil {
+			return nil, err
+		}
+	}
+	// Flags take precedence over the config file.
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "dsn":
+			cfg.DSN = *dsn
+		case "ddl":
+			cfg.DDL = ddl
+		case "information-schema":
+			cfg.InformationSchema = *informationSchema
+		case "out":
+			cfg.OutDir = *outDir
+		case "package":
+			cfg.Package = *pkg
+		case "dry-run":
+			cfg.DryRun = *dryRun
+		case "repositories":
+			cfg.Repositories = *repositories
+		case "update-keys":
+			cfg.UpdateKeys = *updateKeys
+		case "factory-dir":
+			cfg.FactoryDir = *factoryDir
+		case "model-import-path":
+			cfg.ModelImportPath = *modelImportPath
+		case "schema-snapshot":
+			cfg.SchemaSnapshot = *schemaSnapshot
+		case "json-schema-dir":
+			cfg.JSONSchemaDir = *jsonSchemaDir
+		case "check":
+			cfg.Check = *check
+		case "template":
+			cfg.Templates = []exql.ModelTemplate{{}}
+			for _, path := range templates {
+				cfg.Templates = append(cfg.Templates, exql.ModelTemplate{Path: path})
+			}
+		case "include":
+			cfg.Include = include
+		case "exclude":
+			cfg.Exclude = exclude
+		}
+	})
+	for table, fileName := range fileNames {
+		if cfg.FileNameMap == nil {
+			cfg.FileNameMap = map[string]string{}
+		}
+		cfg.FileNameMap[table] = fileName
+	}
+	// Flag mappings go first since the first one wins among mappings of the same kind
+	var mappings []exql.TypeMapping
+	for _, p := range types {
+		column, goType := p[0], p[1]
+		m := exql.TypeMapping{Column: column, GoType: goType}
+		if strings.ContainsAny(column, "*?[") {
+			m = exql.TypeMapping{ColumnPattern: column, GoType: goType}
+		}
+		mappings = append(mappings, m)
+	}
+	cfg.TypeMappings = append(mappings, cfg.TypeMappings...)
+	if cfg.DSN == "" && len(cfg.DDL) == 0 {
+		cfg.DSN = os.Getenv(dsnEnv)
+	}
+	if cfg.DSN == "" && len(cfg.DDL) == 0 {
+		return nil, fmt.Errorf("dsn or ddl is required: set -dsn, -ddl, \"dsn\" in the config or $%s", dsnEnv)
+	} else if cfg.DSN != "" && len(cfg.DDL) > 0 {
+		return nil, fmt.Errorf("dsn and ddl are exclusive")
+	}
+	return cfg, nil
+}
+
+func loadConfigFile(path string, cfg *config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return nil
+}
+
+func generate(ctx context.Context, cfg *config) error {
+	if len(cfg.DDL) > 0 {
+		return exql.NewDDLGenerator(cfg.DDL...).Generate(&cfg.GenerateOptions)
+	}
+	db, err := sql.Open("mysql", cfg.DSN)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	parser := exql.NewParser()
+	if cfg.InformationSchema {
+		parser = exql.NewInformationSchemaParser()
+	}
+	return exql.NewGeneratorWithParser(db, parser).Generate(&cfg.GenerateOptions)
+}
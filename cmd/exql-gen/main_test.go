@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/loilo-inc/exql/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfig(t *testing.T) {
+	writeConfig := func(t *testing.T, content string) string {
+		p := filepath.Join(t.TempDir(), "exql-gen.json")
+		assert.NoError(t, os.WriteFile(p, []byte(content), 0600))
+		return p
+	}
+	t.Run("flags", func(t *testing.T) {
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{
+			"-dsn", "root:@tcp(127.0.0.1:3306)/db",
+			"-out", "dist",
+			"-package", "dist",
+			"-include", "users,user_*",
+			"-exclude", "fields",
+			"-file-name", "users=user.go",
+			"-type", "users.age=uint8",
+			"-dry-run",
+			"-check",
+			"-information-schema",
+		}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, &config{
+			DSN:               "root:@tcp(127.0.0.1:3306)/db",
+			InformationSchema: true,
+			GenerateOptions: exql.GenerateOptions{
+				OutDir:       "dist",
+				Package:      "dist",
+				Include:      []string{"users", "user_*"},
+				Exclude:      []string{"fields"},
+				FileNameMap:  map[string]string{"users": "user.go"},
+				TypeMappings: []exql.TypeMapping{{Column: "users.age", GoType: "uint8"}},
+				DryRun:       true,
+				Check:        true,
+			},
+		}, cfg)
+	})
+	t.Run("flags should override config", func(t *testing.T) {
+		p := writeConfig(t, `{
+			"dsn": "config-dsn",
+			"out_dir": "config",
+			"package": "config",
+			"exclude": ["fields"],
+			"type_mappings": [{"column": "users.id", "go_type": "uint64"}]
+		}`)
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-config", p, "-out", "dist"}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, &config{
+			DSN: "config-dsn",
+			GenerateOptions: exql.GenerateOptions{
+				OutDir:       "dist",
+				Package:      "config",
+				Exclude:      []string{"fields"},
+				TypeMappings: []exql.TypeMapping{{Column: "users.id", GoType: "uint64"}},
+			},
+		}, cfg)
+	})
+	t.Run("type mapping flags should precede config", func(t *testing.T) {
+		p := writeConfig(t, `{"dsn": "dsn", "type_mappings": [{"column": "users.id", "go_type": "uint64"}]}`)
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-config", p, "-type", "users.id=int32"}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, []exql.TypeMapping{
+			{Column: "users.id", GoType: "int32"},
+			{Column: "users.id", GoType: "uint64"},
+		}, cfg.TypeMappings)
+	})
+	t.Run("dsn from env", func(t *testing.T) {
+		t.Setenv(dsnEnv, "env-dsn")
+		var stderr bytes.Buffer
+		cfg, err := parseConfig(nil, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, "env-dsn", cfg.DSN)
+	})
+	t.Run("repositories", func(t *testing.T) {
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-dsn", "dsn", "-repositories"}, &stderr)
+		assert.NoError(t, err)
+		assert.True(t, cfg.Repositories)
+	})
+	t.Run("update keys", func(t *testing.T) {
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-dsn", "dsn", "-update-keys"}, &stderr)
+		assert.NoError(t, err)
+		assert.True(t, cfg.UpdateKeys)
+	})
+	t.Run("factories", func(t *testing.T) {
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-dsn", "dsn", "-factory-dir", "model/factory", "-model-import-path", "example.com/app/model"}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, "model/factory", cfg.FactoryDir)
+		assert.Equal(t, "example.com/app/model", cfg.ModelImportPath)
+	})
+	t.Run("schemas", func(t *testing.T) {
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-dsn", "dsn", "-schema-snapshot", "schema.json", "-json-schema-dir", "schemas"}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, "schema.json", cfg.SchemaSnapshot)
+		assert.Equal(t, "schemas", cfg.JSONSchemaDir)
+	})
+	t.Run("templates", func(t *testing.T) {
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-dsn", "dsn", "-template", "a.tmpl", "-template", "b.tmpl"}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, []exql.ModelTemplate{{}, {Path: "a.tmpl"}, {Path: "b.tmpl"}}, cfg.Templates)
+	})
+	t.Run("type mapping by glob", func(t *testing.T) {
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-dsn", "dsn", "-type", "*_at=time.Time"}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, []exql.TypeMapping{{ColumnPattern: "*_at", GoType: "time.Time"}}, cfg.TypeMappings)
+	})
+	t.Run("type mappings keep the command line order", func(t *testing.T) {
+		// Both patterns match user_id, and the first one is applied
+		for range 20 {
+			var stderr bytes.Buffer
+			cfg, err := parseConfig([]string{"-dsn", "dsn", "-type", "*_id=types.ID", "-type", "user_*=types.UserField", "-type", "users.id=uint64"}, &stderr)
+			assert.NoError(t, err)
+			assert.Equal(t, []exql.TypeMapping{
+				{ColumnPattern: "*_id", GoType: "types.ID"},
+				{ColumnPattern: "user_*", GoType: "types.UserField"},
+				{Column: "users.id", GoType: "uint64"},
+			}, cfg.TypeMappings)
+		}
+	})
+	t.Run("ddl", func(t *testing.T) {
+		t.Setenv(dsnEnv, "env-dsn")
+		var stderr bytes.Buffer
+		cfg, err := parseConfig([]string{"-ddl", "a.sql,b.sql"}, &stderr)
+		assert.NoError(t, err)
+		assert.Equal(t, "", cfg.DSN)
+		assert.Equal(t, []string{"a.sql", "b.sql"}, cfg.DDL)
+	})
+	t.Run("should return error if dsn is missing", func(t *testing.T) {
+		t.Setenv(dsnEnv, "")
+		var stderr bytes.Buffer
+		_, err := parseConfig(nil, &stderr)
+		assert.ErrorContains(t, err, "dsn or ddl is required")
+	})
+	t.Run("should return error if both dsn and ddl are set", func(t *testing.T) {
+		var stderr bytes.Buffer
+		_, err := parseConfig([]string{"-dsn", "dsn", "-ddl", "a.sql"}, &stderr)
+		assert.EqualError(t, err, "dsn and ddl are exclusive")
+	})
+	t.Run("should return error if config has unknown fields", func(t *testing.T) {
+		p := writeConfig(t, `{"dsn": "dsn", "unknown": 1}`)
+		var stderr bytes.Buffer
+		_, err := parseConfig([]string{"-config", p}, &stderr)
+		assert.ErrorContains(t, err, "invalid config file")
+	})
+	t.Run("should return error if mapping flag is malformed", func(t *testing.T) {
+		var stderr bytes.Buffer
+		_, err := parseConfig([]string{"-dsn", "dsn", "-type", "users.age"}, &stderr)
+		assert.ErrorContains(t, err, "must be in the form of key=value")
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("should exit with 2 on invalid usage", func(t *testing.T) {
+		t.Setenv(dsnEnv, "")
+		var stderr bytes.Buffer
+		assert.Equal(t, exitUsage, run(nil, &stderr))
+		assert.Contains(t, stderr.String(), "dsn or ddl is required")
+	})
+	t.Run("should exit with 0 on -h", func(t *testing.T) {
+		var stderr bytes.Buffer
+		assert.Equal(t, exitOK, run([]string{"-h"}, &stderr))
+		assert.Contains(t, stderr.String(), "-dsn")
+	})
+	t.Run("should generate models from ddl", func(t *testing.T) {
+		dir := t.TempDir()
+		var stderr bytes.Buffer
+		assert.Equal(t, exitOK, run([]string{"-ddl", "../../schema/model.sql", "-out", dir, "-include", "users"}, &stderr))
+		_, err := os.Stat(filepath.Join(dir, "users.go"))
+		assert.NoError(t, err)
+	})
+	t.Run("should apply the first of overlapping type mappings", func(t *testing.T) {
+		for range 10 {
+			dir := t.TempDir()
+			var stderr bytes.Buffer
+			assert.Equal(t, exitOK, run([]string{"-ddl", "../../schema/model.sql", "-out", dir, "-include", "group_users",
+				"-type", "*_id=int32", "-type", "user_*=uint32"}, &stderr))
+			source, err := os.ReadFile(filepath.Join(dir, "group_users.go"))
+			assert.NoError(t, err)
+			assert.Contains(t, string(source), "UserId  int32 ")
+		}
+	})
+	t.Run("should exit with 1 on generation failure", func(t *testing.T) {
+		var stderr bytes.Buffer
+		assert.Equal(t, exitError, run([]string{"-dsn", "root:@tcp(127.0.0.1:1)/db?timeout=100ms"}, &stderr))
+		assert.NotEmpty(t, stderr.String())
+	})
+}
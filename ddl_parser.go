@@ -0,0 +1,1162 @@
+package exql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// DDLParser parses CREATE TABLE statements into tables without the database connection.
+// The results are equivalent to what Parser.ParseTable returns for the same schema on MySQL 8.
+type DDLParser interface {
+	// ParseDDL parses statements in ddl and returns the tables in order of creation.
+	// CREATE TABLE, CREATE INDEX, CREATE VIEW, DROP TABLE and DROP VIEW are evaluated, and other statements
+	// except for ALTER TABLE are ignored. Views are parsed only if they select columns of a single table
+	// or view by names, and skipped with a logged warning otherwise, e.g. if they join tables or select expressions.
+	ParseDDL(ddl string) ([]*Table, error)
+	// ParseDDLFiles parses the files in order as if they are concatenated.
+	ParseDDLFiles(paths ...string) ([]*Table, error)
+}
+
+type ddlParser struct{}
+
+func NewDDLParser() DDLParser {
+	return &ddlParser{}
+}
+
+// Index is the index of the table.
+type Index struct {
+	// Name is the name of the index, "PRIMARY" for the primary key.
+	Name string `json:"name"`
+	// Columns are the indexed columns in order.
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique"`
+	Primary bool     `json:"primary"`
+}
+
+// ForeignKey is the foreign key constraint of the table.
+type ForeignKey struct {
+	Name              string   `json:"name"`
+	Columns           []string `json:"columns"`
+	ReferencedTable   string   `json:"referenced_table"`
+	ReferencedColumns []string `json:"referenced_columns"`
+}
+
+func (p *ddlParser) ParseDDLFiles(paths ...string) ([]*Table, error) {
+	var ddl strings.Builder
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		ddl.Write(b)
+		// Statements must not continue across files
+		ddl.WriteString("\n;\n")
+	}
+	return p.ParseDDL(ddl.String())
+}
+
+func (p *ddlParser) ParseDDL(ddl string) ([]*Table, error) {
+	tokens, err := tokenizeDDL(ddl)
+	if err != nil {
+		return nil, err
+	}
+	s := &ddlSchema{}
+	for _, stmt := range splitStatements(tokens) {
+		if err := s.evaluate(stmt); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range s.tables {
+		if err := completeTable(t); err != nil {
+			return nil, err
+		}
+	}
+	return s.tables, nil
+}
+
+type ddlTokenKind int
+
+const (
+	ddlIdent ddlTokenKind = iota
+	// ddlQuoted is the identifier quoted with backticks
+	ddlQuoted
+	ddlString
+	ddlNumber
+	ddlSymbol
+)
+
+type ddlToken struct {
+	kind ddlTokenKind
+	text string
+	// pos is the byte offset in the source for error messages
+	pos int
+}
+
+// is reports whether the token is the unquoted keyword or symbol s, case-insensitively.
+func (t ddlToken) is(s string) bool {
+	return (t.kind == ddlIdent || t.kind == ddlSymbol) && strings.EqualFold(t.text, s)
+}
+
+func (t ddlToken) isName() bool {
+	return t.kind == ddlIdent || t.kind == ddlQuoted
+}
+
+func tokenizeDDL(src string) ([]ddlToken, error) {
+	var tokens []ddlToken
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || (c == '-' && strings.HasPrefix(src[i:], "-- ")) || strings.HasPrefix(src[i:], "--\n"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at %d", i)
+			}
+			i += end + 4
+		case c == '`':
+			start := i
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(src) {
+					return nil, fmt.Errorf("unterminated quoted identifier at %d", start)
+				}
+				if src[i] == '`' {
+					if i+1 < len(src) && src[i+1] == '`' {
+						b.WriteByte('`')
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(src[i])
+			}
+			tokens = append(tokens, ddlToken{kind: ddlQuoted, text: b.String(), pos: start})
+		case c == '\'' || c == '"':
+			start := i
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(src) {
+					return nil, fmt.Errorf("unterminated string at %d", start)
+				}
+				if src[i] == '\\' && i+1 < len(src) {
+					i++
+					b.WriteByte(unescapeDDLChar(src[i]))
+					continue
+				}
+				if src[i] == c {
+					if i+1 < len(src) && src[i+1] == c {
+						b.WriteByte(c)
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(src[i])
+			}
+			tokens = append(tokens, ddlToken{kind: ddlString, text: b.String(), pos: start})
+		case isDDLDigit(c) || (c == '.' && i+1 < len(src) && isDDLDigit(src[i+1])):
+			start := i
+			for i < len(src) && (isDDLDigit(src[i]) || src[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlNumber, text: src[start:i], pos: start})
+		case isDDLIdentChar(c):
+			start := i
+			for i < len(src) && isDDLIdentChar(src[i]) {
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlIdent, text: src[start:i], pos: start})
+		default:
+			tokens = append(tokens, ddlToken{kind: ddlSymbol, text: string(c), pos: i})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+func unescapeDDLChar(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	case '0':
+		return 0
+	}
+	return c
+}
+
+func isDDLDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isDDLIdentChar(c byte) bool {
+	return isDDLDigit(c) || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || c == '_' || c == '$' || c >= 0x80
+}
+
+func splitStatements(tokens []ddlToken) [][]ddlToken {
+	var stmts [][]ddlToken
+	start := 0
+	for i, t := range tokens {
+		if t.is(";") {
+			if i > start {
+				stmts = append(stmts, tokens[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(tokens) {
+		stmts = append(stmts, tokens[start:])
+	}
+	return stmts
+}
+
+// ddlStream reads tokens of a statement sequentially.
+type ddlStream struct {
+	tokens []ddlToken
+	i      int
+}
+
+func (s *ddlStream) eof() bool {
+	return s.i >= len(s.tokens)
+}
+
+func (s *ddlStream) peek() ddlToken {
+	if s.eof() {
+		return ddlToken{kind: ddlSymbol, pos: -1}
+	}
+	return s.tokens[s.i]
+}
+
+func (s *ddlStream) next() ddlToken {
+	t := s.peek()
+	s.i++
+	return t
+}
+
+// accept consumes the keywords if they follow in order.
+func (s *ddlStream) accept(keywords ...string) bool {
+	for j, k := range keywords {
+		if s.i+j >= len(s.tokens) || !s.tokens[s.i+j].is(k) {
+			return false
+		}
+	}
+	s.i += len(keywords)
+	return true
+}
+
+func (s *ddlStream) expect(keyword string) error {
+	if !s.accept(keyword) {
+		return s.errorf("expected %s", keyword)
+	}
+	return nil
+}
+
+func (s *ddlStream) errorf(format string, args ...any) error {
+	t := s.peek()
+	if t.pos < 0 {
+		return fmt.Errorf(format+" but got end of statement", args...)
+	}
+	return fmt.Errorf(format+" but got %q at %d", append(args, t.text, t.pos)...)
+}
+
+func (s *ddlStream) name() (string, error) {
+	if !s.peek().isName() {
+		return "", s.errorf("expected name")
+	}
+	return s.next().text, nil
+}
+
+// tableName reads the table name, discarding the schema qualifier.
+func (s *ddlStream) tableName() (string, error) {
+	name, err := s.name()
+	if err != nil {
+		return "", err
+	}
+	if s.accept(".") {
+		return s.name()
+	}
+	return name, nil
+}
+
+// group reads the parenthesized tokens and returns the inner ones.
+func (s *ddlStream) group() ([]ddlToken, error) {
+	if err := s.expect("("); err != nil {
+		return nil, err
+	}
+	start := s.i
+	depth := 1
+	for !s.eof() {
+		t := s.next()
+		if t.is("(") {
+			depth++
+		} else if t.is(")") {
+			depth--
+			if depth == 0 {
+				return s.tokens[start : s.i-1], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unbalanced parentheses")
+}
+
+// skipUntil skips tokens, including groups, until one of keywords at the top level.
+func (s *ddlStream) skipUntil(keywords ...string) {
+	for !s.eof() {
+		t := s.peek()
+		for _, k := range keywords {
+			if t.is(k) {
+				return
+			}
+		}
+		if t.is("(") {
+			if _, err := s.group(); err != nil {
+				s.i = len(s.tokens)
+			}
+			continue
+		}
+		s.i++
+	}
+}
+
+// splitByComma splits tokens by commas at the top level.
+func splitByComma(tokens []ddlToken) [][]ddlToken {
+	var ret [][]ddlToken
+	depth := 0
+	start := 0
+	for i, t := range tokens {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case t.is(",") && depth == 0:
+			ret = append(ret, tokens[start:i])
+			start = i + 1
+		}
+	}
+	return append(ret, tokens[start:])
+}
+
+type ddlSchema struct {
+	tables []*Table
+}
+
+func (s *ddlSchema) table(name string) *Table {
+	for _, t := range s.tables {
+		if strings.EqualFold(t.TableName, name) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *ddlSchema) evaluate(tokens []ddlToken) error {
+	st := &ddlStream{tokens: tokens}
+	switch {
+	case st.accept("create"):
+		replace := st.accept("or", "replace")
+		if st.peek().is("algorithm") || st.peek().is("definer") || st.peek().is("sql") || st.peek().is("view") {
+			// DEFINER and SQL SECURITY are also the options of procedures, functions, triggers and events
+			st.skipUntil("view", "procedure", "function", "trigger", "event")
+			if st.accept("view") {
+				return s.createView(st, replace)
+			}
+			return nil
+		}
+		st.accept("temporary")
+		if st.accept("table") {
+			return s.createTable(st)
+		}
+		st.accept("online")
+		st.accept("offline")
+		unique := st.accept("unique")
+		if !unique && !st.accept("fulltext") {
+			st.accept("spatial")
+		}
+		if st.accept("index") {
+			return s.createIndex(st, unique)
+		}
+	case st.accept("drop", "table"), st.accept("drop", "view"):
+		return s.dropTable(st)
+	case st.accept("alter", "table"):
+		return fmt.Errorf("unsupported statement: ALTER TABLE")
+	}
+	return nil
+}
+
+func (s *ddlSchema) createTable(st *ddlStream) error {
+	ifNotExists := st.accept("if", "not", "exists")
+	name, err := st.tableName()
+	if err != nil {
+		return err
+	}
+	if s.table(name) != nil {
+		if ifNotExists {
+			// The existing one is kept as MySQL does
+			return nil
+		}
+		return fmt.Errorf("table already exists: %s", name)
+	}
+	if st.accept("like") {
+		return fmt.Errorf("unsupported statement: CREATE TABLE ... LIKE")
+	}
+	defs, err := st.group()
+	if err != nil {
+		return err
+	}
+	t := &Table{TableName: name}
+	for _, def := range splitByComma(defs) {
+		if err := parseTableElement(t, &ddlStream{tokens: def}); err != nil {
+			return fmt.Errorf("table %s: %w", name, err)
+		}
+	}
+	// Only COMMENT is read from table options
+	for !st.eof() {
+		if st.accept("comment") {
+			st.accept("=")
+			t.Comment = st.next().text
+		} else if st.accept("partition") {
+			break
+		} else {
+			st.next()
+		}
+	}
+	s.tables = append(s.tables, t)
+	return nil
+}
+
+func (s *ddlSchema) createIndex(st *ddlStream, unique bool) error {
+	name, err := st.name()
+	if err != nil {
+		return err
+	}
+	st.skipUntil("on")
+	if err := st.expect("on"); err != nil {
+		return err
+	}
+	tableName, err := st.tableName()
+	if err != nil {
+		return err
+	}
+	t := s.table(tableName)
+	if t == nil {
+		return fmt.Errorf("table not found: %s", tableName)
+	}
+	cols, err := parseKeyParts(st)
+	if err != nil {
+		return err
+	}
+	return addIndex(t, &Index{Name: name, Columns: cols, Unique: unique})
+}
+
+func (s *ddlSchema) dropTable(st *ddlStream) error {
+	st.accept("if", "exists")
+	for {
+		name, err := st.tableName()
+		if err != nil {
+			return err
+		}
+		s.drop(name)
+		if !st.accept(",") {
+			return nil
+		}
+	}
+}
+
+func (s *ddlSchema) drop(name string) {
+	for i, t := range s.tables {
+		if strings.EqualFold(t.TableName, name) {
+			s.tables = append(s.tables[:i], s.tables[i+1:]...)
+			return
+		}
+	}
+}
+
+// errUnsupportedView is returned for views whose columns can't be told by the statement.
+var errUnsupportedView = errors.New("unsupported view")
+
+// ddlViewSource is the table or the view referenced in the FROM clause of the view.
+type ddlViewSource struct {
+	// alias is the name of the table or its alias in the view
+	alias string
+	table *Table
+}
+
+// createView reads `view_name [(column_list)] AS SELECT ...` and adds the view with columns
+// copied from the selected ones. Columns of views are resolved on creation as MySQL does.
+func (s *ddlSchema) createView(st *ddlStream, replace bool) error {
+	name, err := st.tableName()
+	if err != nil {
+		return err
+	}
+	if t := s.table(name); t != nil {
+		if !replace || !t.View {
+			return fmt.Errorf("table already exists: %s", name)
+		}
+		s.drop(name)
+	}
+	var names []string
+	if st.peek().is("(") {
+		if names, err = parseKeyParts(st); err != nil {
+			return err
+		}
+	}
+	if err := st.expect("as"); err != nil {
+		return err
+	}
+	cols, err := s.viewColumns(st)
+	if errors.Is(err, errUnsupportedView) {
+		log.Printf("skipped view %s: its columns can't be resolved from the select statement", name)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("view %s: %w", name, err)
+	}
+	if names != nil {
+		if len(names) != len(cols) {
+			return fmt.Errorf("view %s: column list doesn't match the select list", name)
+		}
+		for i, c := range cols {
+			c.FieldName = names[i]
+		}
+	}
+	for i, c := range cols {
+		for _, d := range cols[:i] {
+			if strings.EqualFold(c.FieldName, d.FieldName) {
+				return fmt.Errorf("view %s: duplicate column name: %s", name, c.FieldName)
+			}
+		}
+	}
+	s.tables = append(s.tables, &Table{TableName: name, View: true, Columns: cols})
+	return nil
+}
+
+// viewColumns reads `SELECT select_expr,... FROM table_references ...` and returns the selected columns.
+func (s *ddlSchema) viewColumns(st *ddlStream) ([]*Column, error) {
+	if !st.accept("select") {
+		// Parenthesized queries, WITH, TABLE and VALUES
+		return nil, errUnsupportedView
+	}
+	if !st.accept("distinct") && !st.accept("distinctrow") {
+		st.accept("all")
+	}
+	start := st.i
+	st.skipUntil("from")
+	items := st.tokens[start:st.i]
+	if !st.accept("from") {
+		return nil, errUnsupportedView
+	}
+	start = st.i
+	st.skipUntil("where", "group", "having", "window", "order", "limit", "union", "with")
+	refs := st.tokens[start:st.i]
+	if st.skipUntil("union"); !st.eof() {
+		return nil, errUnsupportedView
+	}
+	src, err := s.viewSource(&ddlStream{tokens: refs})
+	if err != nil {
+		return nil, err
+	}
+	var cols []*Column
+	for _, item := range splitByComma(items) {
+		selected, err := selectViewColumns(&ddlStream{tokens: item}, src)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range selected {
+			c.FieldIndex = len(cols)
+			cols = append(cols, c)
+		}
+	}
+	return cols, nil
+}
+
+// viewSource reads `tbl_name [[AS] alias]`.
+// Only views of a single table or view are supported.
+func (s *ddlSchema) viewSource(st *ddlStream) (*ddlViewSource, error) {
+	if st.peek().is("(") {
+		// Derived tables and nested joins
+		return nil, errUnsupportedView
+	}
+	name, err := st.tableName()
+	if err != nil {
+		return nil, err
+	}
+	t := s.table(name)
+	if t == nil {
+		return nil, fmt.Errorf("table not found: %s", name)
+	}
+	src := &ddlViewSource{alias: name, table: t}
+	if st.accept("as") {
+		if src.alias, err = st.name(); err != nil {
+			return nil, err
+		}
+	} else if p := st.peek(); p.kind == ddlQuoted || p.kind == ddlIdent {
+		src.alias = st.next().text
+	}
+	if !st.eof() {
+		// Joins, index hints and partitions
+		return nil, errUnsupportedView
+	}
+	return src, nil
+}
+
+// selectViewColumns reads `*`, `tbl_name.*` or `[tbl_name.]col_name [[AS] alias]` and returns the columns.
+func selectViewColumns(st *ddlStream, src *ddlViewSource) ([]*Column, error) {
+	var path []string
+	for {
+		if st.accept("*") {
+			path = append(path, "*")
+			break
+		}
+		if !st.peek().isName() {
+			return nil, errUnsupportedView
+		}
+		path = append(path, st.next().text)
+		if !st.accept(".") {
+			break
+		}
+	}
+	name := path[len(path)-1]
+	var qualifier string
+	if len(path) > 1 {
+		// The schema name is discarded
+		qualifier = path[len(path)-2]
+	}
+	alias := name
+	if st.accept("as") || (!st.eof() && name != "*") {
+		if p := st.peek(); !p.isName() && p.kind != ddlString {
+			return nil, errUnsupportedView
+		}
+		alias = st.next().text
+	}
+	if !st.eof() {
+		// Expressions
+		return nil, errUnsupportedView
+	}
+	var cols []*Column
+	if qualifier != "" && !strings.EqualFold(src.alias, qualifier) {
+		return nil, fmt.Errorf("unknown table: %s", qualifier)
+	}
+	for _, c := range src.table.Columns {
+		if name == "*" {
+			cols = append(cols, viewColumn(src, c, c.FieldName))
+		} else if strings.EqualFold(c.FieldName, name) {
+			return []*Column{viewColumn(src, c, alias)}, nil
+		}
+	}
+	if name != "*" {
+		return nil, fmt.Errorf("unknown column: %s", strings.Join(path, "."))
+	}
+	return cols, nil
+}
+
+// viewColumn returns the column of the view selecting c of src as name.
+func viewColumn(src *ddlViewSource, c *Column, name string) *Column {
+	nullable := c.Nullable
+	for _, idx := range src.table.Indexes {
+		// Primary key columns are implicitly NOT NULL
+		if idx.Primary && containsColumn(idx.Columns, c.FieldName) {
+			nullable = false
+		}
+	}
+	return &Column{
+		FieldName:    name,
+		FieldType:    c.FieldType,
+		Nullable:     nullable,
+		DefaultValue: c.DefaultValue,
+		Comment:      c.Comment,
+		CharacterSet: c.CharacterSet,
+		Collation:    c.Collation,
+	}
+}
+
+func parseTableElement(t *Table, st *ddlStream) error {
+	var constraint string
+	if st.accept("constraint") {
+		if st.peek().isName() && !st.peek().is("primary") && !st.peek().is("unique") &&
+			!st.peek().is("foreign") && !st.peek().is("check") {
+			constraint = st.next().text
+		}
+	}
+	switch {
+	case st.accept("primary", "key"):
+		cols, err := parseIndexColumns(st)
+		if err != nil {
+			return err
+		}
+		return addIndex(t, &Index{Name: "PRIMARY", Columns: cols, Unique: true, Primary: true})
+	case st.accept("unique"):
+		if !st.accept("index") {
+			st.accept("key")
+		}
+		name := constraint
+		if st.peek().isName() && !st.peek().is("using") {
+			name = st.next().text
+		}
+		cols, err := parseIndexColumns(st)
+		if err != nil {
+			return err
+		}
+		return addIndex(t, &Index{Name: name, Columns: cols, Unique: true})
+	case st.accept("foreign", "key"):
+		if st.peek().isName() {
+			// index_name is ignored if the constraint name is given
+			name := st.next().text
+			if constraint == "" {
+				constraint = name
+			}
+		}
+		cols, err := parseKeyParts(st)
+		if err != nil {
+			return err
+		} else if len(cols) == 0 {
+			return fmt.Errorf("foreign key has no columns")
+		}
+		refTable, refCols, err := parseReference(st)
+		if err != nil {
+			return err
+		}
+		t.ForeignKeys = append(t.ForeignKeys, &ForeignKey{
+			Name:              constraint,
+			Columns:           cols,
+			ReferencedTable:   refTable,
+			ReferencedColumns: refCols,
+		})
+		return nil
+	case st.accept("check"):
+		return nil
+	case st.accept("index"), st.accept("key"),
+		st.accept("fulltext"), st.accept("spatial"):
+		if !st.accept("index") {
+			st.accept("key")
+		}
+		var name string
+		if st.peek().isName() && !st.peek().is("using") {
+			name = st.next().text
+		}
+		cols, err := parseIndexColumns(st)
+		if err != nil {
+			return err
+		}
+		return addIndex(t, &Index{Name: name, Columns: cols})
+	}
+	if constraint != "" {
+		return st.errorf("expected constraint")
+	}
+	return parseColumnDefinition(t, st)
+}
+
+// parseIndexColumns reads `[USING type] (key_part,...)`.
+func parseIndexColumns(st *ddlStream) ([]string, error) {
+	if st.accept("using") {
+		st.next()
+	}
+	return parseKeyParts(st)
+}
+
+// parseKeyParts reads `(key_part,...)` and returns the column names.
+// Functional key parts are ignored as they have no column.
+func parseKeyParts(st *ddlStream) ([]string, error) {
+	group, err := st.group()
+	if err != nil {
+		return nil, err
+	}
+	var cols []string
+	for _, part := range splitByComma(group) {
+		if len(part) == 0 {
+			return nil, st.errorf("empty key part")
+		}
+		if part[0].isName() {
+			cols = append(cols, part[0].text)
+		}
+	}
+	return cols, nil
+}
+
+// parseReference reads `REFERENCES tbl (key_part,...)` and skips the following options.
+func parseReference(st *ddlStream) (string, []string, error) {
+	if err := st.expect("references"); err != nil {
+		return "", nil, err
+	}
+	table, err := st.tableName()
+	if err != nil {
+		return "", nil, err
+	}
+	cols, err := parseKeyParts(st)
+	if err != nil {
+		return "", nil, err
+	}
+	st.i = len(st.tokens)
+	return table, cols, nil
+}
+
+func parseColumnDefinition(t *Table, st *ddlStream) error {
+	name, err := st.name()
+	if err != nil {
+		return err
+	}
+	col := &Column{FieldName: name, Nullable: true, FieldIndex: len(t.Columns)}
+	col.FieldType, err = parseColumnType(st, col)
+	if err != nil {
+		return err
+	}
+	var extra []string
+	var onUpdate string
+	for !st.eof() {
+		switch {
+		case st.accept("not", "null"):
+			col.Nullable = false
+		case st.accept("null"):
+			col.Nullable = true
+		case st.accept("auto_increment"):
+			extra = append(extra, "auto_increment")
+		case st.accept("default"):
+			v, generated, err := parseDefaultValue(st)
+			if err != nil {
+				return err
+			}
+			col.DefaultValue = v
+			if generated {
+				extra = append(extra, "DEFAULT_GENERATED")
+			}
+		case st.accept("on", "update"):
+			v, _, err := parseDefaultValue(st)
+			if err != nil {
+				return err
+			}
+			onUpdate = "on update " + v.String
+		case st.accept("primary", "key"), st.accept("key"):
+			if err := addIndex(t, &Index{Name: "PRIMARY", Columns: []string{name}, Unique: true, Primary: true}); err != nil {
+				return err
+			}
+		case st.accept("unique"):
+			st.accept("key")
+			if err := addIndex(t, &Index{Columns: []string{name}, Unique: true}); err != nil {
+				return err
+			}
+		case st.accept("comment"):
+			col.Comment = st.next().text
+		case st.accept("collate"):
+			col.Collation = strings.ToLower(st.next().text)
+		case st.accept("charset"), st.accept("character", "set"):
+			col.CharacterSet = strings.ToLower(st.next().text)
+		case st.accept("column_format"),
+			st.accept("storage"), st.accept("srid"), st.accept("engine_attribute"),
+			st.accept("secondary_engine_attribute"):
+			st.accept("=")
+			st.next()
+		case st.accept("generated", "always"), st.peek().is("as"):
+			return fmt.Errorf("unsupported generated column: %s", name)
+		case st.accept("references"):
+			// Inline references are parsed but ignored by MySQL
+			st.i = len(st.tokens)
+		case st.accept("constraint"):
+			if !st.peek().is("check") {
+				st.next()
+			}
+		case st.accept("check"):
+			if _, err := st.group(); err != nil {
+				return err
+			}
+			st.accept("not")
+			st.accept("enforced")
+		case st.accept("visible"), st.accept("invisible"):
+		default:
+			return st.errorf("unexpected token in column %s", name)
+		}
+	}
+	if onUpdate != "" {
+		extra = append(extra, onUpdate)
+	}
+	if len(extra) > 0 {
+		col.Extra = sql.NullString{String: strings.Join(extra, " "), Valid: true}
+	}
+	t.Columns = append(t.Columns, col)
+	return nil
+}
+
+// ddlTypeAliases maps type names to the canonical ones shown by MySQL.
+var ddlTypeAliases = map[string]string{
+	"integer":   "int",
+	"int1":      "tinyint",
+	"int2":      "smallint",
+	"int3":      "mediumint",
+	"int4":      "int",
+	"int8":      "bigint",
+	"middleint": "mediumint",
+	"dec":       "decimal",
+	"numeric":   "decimal",
+	"fixed":     "decimal",
+	"real":      "double",
+	"float4":    "float",
+	"float8":    "double",
+	"bool":      "tinyint",
+	"boolean":   "tinyint",
+}
+
+// ddlDefaultTypeArgs are the arguments filled by MySQL if omitted.
+var ddlDefaultTypeArgs = map[string]string{
+	"decimal": "(10,0)",
+	"bit":     "(1)",
+	"char":    "(1)",
+	"binary":  "(1)",
+}
+
+// ddlWidthTypes are the types whose display widths are not shown by MySQL 8.
+var ddlWidthTypes = map[string]bool{
+	"tinyint": true, "smallint": true, "mediumint": true, "int": true, "bigint": true, "year": true,
+}
+
+// parseColumnType reads the data type and returns it in the format of SHOW COLUMNS on MySQL 8,
+// e.g. `INT(11) UNSIGNED` is "int unsigned" as the display width is deprecated.
+func parseColumnType(st *ddlStream, col *Column) (string, error) {
+	t := st.next()
+	if t.kind != ddlIdent {
+		return "", fmt.Errorf("expected data type of %s but got %q", col.FieldName, t.text)
+	}
+	base := strings.ToLower(t.text)
+	var args string
+	switch {
+	case base == "double":
+		st.accept("precision")
+	case base == "character" || base == "nchar" || base == "national":
+		if base == "national" {
+			st.next()
+		}
+		base = "char"
+		if st.accept("varying") {
+			base = "varchar"
+		}
+	case base == "nvarchar":
+		base = "varchar"
+	case base == "long":
+		base = "mediumtext"
+		if st.accept("varbinary") {
+			base = "mediumblob"
+		}
+		st.accept("varchar")
+	case base == "bool" || base == "boolean":
+		args = "(1)"
+	case base == "serial":
+		// SERIAL is an alias for BIGINT UNSIGNED NOT NULL AUTO_INCREMENT UNIQUE
+		col.Nullable = false
+		col.Extra = sql.NullString{String: "auto_increment", Valid: true}
+		return "bigint unsigned", nil
+	}
+	if alias, ok := ddlTypeAliases[base]; ok {
+		base = alias
+	}
+	if st.peek().is("(") {
+		group, err := st.group()
+		if err != nil {
+			return "", err
+		}
+		args = "(" + joinDDLTokens(group) + ")"
+	} else if def, ok := ddlDefaultTypeArgs[base]; ok && args == "" {
+		args = def
+	}
+	st.accept("signed")
+	unsigned := st.accept("unsigned")
+	zerofill := st.accept("zerofill")
+	// CHAR BINARY is the binary collation, not the type
+	st.accept("binary")
+	if ddlWidthTypes[base] && !zerofill && !(base == "tinyint" && args == "(1)") {
+		args = ""
+	}
+	typ := base + args
+	if unsigned || zerofill {
+		// ZEROFILL implies UNSIGNED
+		typ += " unsigned"
+	}
+	if zerofill {
+		typ += " zerofill"
+	}
+	return typ, nil
+}
+
+// joinDDLTokens renders the tokens compactly as MySQL shows type arguments, e.g. enum('a','b').
+func joinDDLTokens(tokens []ddlToken) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		switch t.kind {
+		case ddlString:
+			b.WriteString("'" + strings.ReplaceAll(t.text, "'", "''") + "'")
+		case ddlQuoted:
+			b.WriteString("`" + strings.ReplaceAll(t.text, "`", "``") + "`")
+		case ddlIdent:
+			b.WriteString(strings.ToLower(t.text))
+		default:
+			b.WriteString(t.text)
+		}
+	}
+	return b.String()
+}
+
+// parseDefaultValue reads the default value and reports whether it is an expression.
+func parseDefaultValue(st *ddlStream) (sql.NullString, bool, error) {
+	t := st.next()
+	switch {
+	case t.kind == ddlString:
+		return sql.NullString{String: t.text, Valid: true}, false, nil
+	case t.kind == ddlNumber:
+		return sql.NullString{String: t.text, Valid: true}, false, nil
+	case t.is("-") || t.is("+"):
+		n := st.next()
+		if n.kind != ddlNumber {
+			return sql.NullString{}, false, st.errorf("expected number")
+		}
+		v := n.text
+		if t.is("-") {
+			v = "-" + v
+		}
+		return sql.NullString{String: v, Valid: true}, false, nil
+	case t.is("null"):
+		return sql.NullString{}, false, nil
+	case t.is("true"):
+		return sql.NullString{String: "1", Valid: true}, false, nil
+	case t.is("false"):
+		return sql.NullString{String: "0", Valid: true}, false, nil
+	case t.is("("):
+		st.i--
+		group, err := st.group()
+		if err != nil {
+			return sql.NullString{}, false, err
+		}
+		return sql.NullString{String: joinDDLTokens(group), Valid: true}, true, nil
+	case t.kind == ddlIdent:
+		// CURRENT_TIMESTAMP, NOW() and so on
+		v := strings.ToUpper(t.text)
+		switch v {
+		case "NOW", "LOCALTIME", "LOCALTIMESTAMP":
+			v = "CURRENT_TIMESTAMP"
+		}
+		if st.peek().is("(") {
+			args, err := st.group()
+			if err != nil {
+				return sql.NullString{}, false, err
+			}
+			if len(args) > 0 {
+				v += "(" + joinDDLTokens(args) + ")"
+			}
+		}
+		return sql.NullString{String: v, Valid: true}, true, nil
+	}
+	return sql.NullString{}, false, fmt.Errorf("unexpected default value %q at %d", t.text, t.pos)
+}
+
+func addIndex(t *Table, idx *Index) error {
+	if len(idx.Columns) == 0 {
+		return fmt.Errorf("index has no columns: %s", idx.Name)
+	}
+	for _, i := range t.Indexes {
+		if idx.Primary && i.Primary {
+			return fmt.Errorf("multiple primary key defined")
+		}
+	}
+	if idx.Name == "" {
+		idx.Name = uniqueIndexName(t, idx.Columns[0])
+	}
+	t.Indexes = append(t.Indexes, idx)
+	return nil
+}
+
+// uniqueIndexName returns the name of the unnamed index as MySQL does: col, col_2, col_3...
+func uniqueIndexName(t *Table, base string) string {
+	name := base
+	for n := 2; ; n++ {
+		exists := false
+		for _, i := range t.Indexes {
+			if strings.EqualFold(i.Name, name) {
+				exists = true
+			}
+		}
+		if !exists {
+			return name
+		}
+		name = fmt.Sprintf("%s_%d", base, n)
+	}
+}
+
+// completeTable fills the attributes derived from the whole table definition.
+func completeTable(t *Table) error {
+	unnamed := 0
+	for _, fk := range t.ForeignKeys {
+		indexName := fk.Name
+		if fk.Name == "" {
+			unnamed++
+			fk.Name = fmt.Sprintf("%s_ibfk_%d", t.TableName, unnamed)
+			indexName = uniqueIndexName(t, fk.Columns[0])
+		}
+		// InnoDB creates the index for the foreign key if there is no index to be used
+		covered := false
+		for _, idx := range t.Indexes {
+			if hasPrefixColumns(idx.Columns, fk.Columns) {
+				covered = true
+			}
+		}
+		if !covered {
+			t.Indexes = append(t.Indexes, &Index{Name: indexName, Columns: fk.Columns})
+		}
+	}
+	for _, col := range t.Columns {
+		// SHOW COLUMNS shows the key of the highest priority: PRI, UNI and MUL
+		key := ""
+		for _, idx := range t.Indexes {
+			switch {
+			case idx.Primary && containsColumn(idx.Columns, col.FieldName):
+				key = "PRI"
+				// Primary key columns are implicitly NOT NULL
+				col.Nullable = false
+			case !strings.EqualFold(idx.Columns[0], col.FieldName) || key == "PRI":
+			case idx.Unique && len(idx.Columns) == 1:
+				key = "UNI"
+			case key == "":
+				key = "MUL"
+			}
+		}
+		col.Key = sql.NullString{String: key, Valid: true}
+		if !col.Extra.Valid {
+			col.Extra = sql.NullString{String: "", Valid: true}
+		}
+		parsedType, err := ParseType(col.FieldType, col.Nullable)
+		if err != nil {
+			return fmt.Errorf("table %s: %w", t.TableName, err)
+		}
+		col.GoFieldType = parsedType
+	}
+	return nil
+}
+
+func hasPrefixColumns(cols, prefix []string) bool {
+	if len(prefix) > len(cols) {
+		return false
+	}
+	for i := range prefix {
+		if !strings.EqualFold(cols[i], prefix[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsColumn(cols []string, col string) bool {
+	for _, c := range cols {
+		if strings.EqualFold(c, col) {
+			return true
+		}
+	}
+	return false
+}
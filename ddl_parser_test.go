@@ -0,0 +1,254 @@
+package exql
+
+import (
+	"bytes"
+	"database/sql"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDDLParser_ParseDDL(t *testing.T) {
+	p := NewDDLParser()
+	str := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	t.Run("columns", func(t *testing.T) {
+		tables, err := p.ParseDDL("-- users\n" + `
+CREATE TABLE IF NOT EXISTS db.` + "`users`" + ` (
+	` + "`id`" + ` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'ID',
+	name VARCHAR(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT 'it''s',
+	age INTEGER DEFAULT -1,
+	active BOOLEAN NOT NULL DEFAULT TRUE,
+	note TEXT NULL, # nullable
+	created_at DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+	updated_at TIMESTAMP NOT NULL DEFAULT now() ON UPDATE CURRENT_TIMESTAMP,
+	PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='all users';
+/* other statements are ignored */
+INSERT INTO users (name) VALUES ('a;b');
+`)
+		assert.NoError(t, err)
+		assert.Equal(t, []*Table{{
+			TableName: "users",
+			Comment:   "all users",
+			Columns: []*Column{
+				{FieldName: "id", FieldType: "bigint unsigned", FieldIndex: 0, GoFieldType: "uint64", Key: str("PRI"), Extra: str("auto_increment"), Comment: "ID"},
+				{
+					FieldName: "name", FieldType: "varchar(255)", FieldIndex: 1, GoFieldType: "string", DefaultValue: str("it's"), Key: str(""), Extra: str(""),
+					CharacterSet: "utf8mb4", Collation: "utf8mb4_bin",
+				},
+				{FieldName: "age", FieldType: "int", FieldIndex: 2, GoFieldType: "null.Int64", Nullable: true, DefaultValue: str("-1"), Key: str(""), Extra: str("")},
+				{FieldName: "active", FieldType: "tinyint(1)", FieldIndex: 3, GoFieldType: "bool", DefaultValue: str("1"), Key: str(""), Extra: str("")},
+				{FieldName: "note", FieldType: "text", FieldIndex: 4, GoFieldType: "null.String", Nullable: true, Key: str(""), Extra: str("")},
+				{FieldName: "created_at", FieldType: "datetime(3)", FieldIndex: 5, GoFieldType: "time.Time", DefaultValue: str("CURRENT_TIMESTAMP(3)"), Key: str(""), Extra: str("DEFAULT_GENERATED")},
+				{FieldName: "updated_at", FieldType: "timestamp", FieldIndex: 6, GoFieldType: "time.Time", DefaultValue: str("CURRENT_TIMESTAMP"), Key: str(""), Extra: str("DEFAULT_GENERATED on update CURRENT_TIMESTAMP")},
+			},
+			Indexes: []*Index{{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true}},
+		}}, tables)
+	})
+	t.Run("indexes and foreign keys", func(t *testing.T) {
+		tables, err := p.ParseDDL(`
+create table users (id int primary key, email varchar(64) unique, code char(4), tenant_id int);
+create table user_groups (
+	user_id int not null,
+	group_id int not null,
+	note varchar(16),
+	primary key (user_id, group_id),
+	unique key uniq_note (note, user_id),
+	key (group_id) using btree,
+	constraint fk_user foreign key (user_id) references users (id) on delete cascade,
+	foreign key (group_id) references ` + "`groups`" + `(id)
+);
+create unique index users_code on users (code(2) desc);
+create index users_tenant using btree on users (tenant_id, email);
+`)
+		assert.NoError(t, err)
+		if !assert.Len(t, tables, 2) {
+			return
+		}
+		users, groups := tables[0], tables[1]
+		assert.Equal(t, []*Index{
+			{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true},
+			{Name: "email", Columns: []string{"email"}, Unique: true},
+			{Name: "users_code", Columns: []string{"code"}, Unique: true},
+			{Name: "users_tenant", Columns: []string{"tenant_id", "email"}},
+		}, users.Indexes)
+		var keys []string
+		for _, c := range users.Columns {
+			keys = append(keys, c.Key.String)
+		}
+		assert.Equal(t, []string{"PRI", "UNI", "UNI", "MUL"}, keys)
+		assert.False(t, users.Columns[0].Nullable)
+
+		assert.Equal(t, []*Index{
+			{Name: "PRIMARY", Columns: []string{"user_id", "group_id"}, Unique: true, Primary: true},
+			{Name: "uniq_note", Columns: []string{"note", "user_id"}, Unique: true},
+			{Name: "group_id", Columns: []string{"group_id"}},
+		}, groups.Indexes)
+		assert.Equal(t, []*ForeignKey{
+			{Name: "fk_user", Columns: []string{"user_id"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+			{Name: "user_groups_ibfk_1", Columns: []string{"group_id"}, ReferencedTable: "groups", ReferencedColumns: []string{"id"}},
+		}, groups.ForeignKeys)
+		keys = nil
+		for _, c := range groups.Columns {
+			keys = append(keys, c.Key.String)
+		}
+		assert.Equal(t, []string{"PRI", "PRI", "MUL"}, keys)
+	})
+	t.Run("should create index for foreign key without index", func(t *testing.T) {
+		tables, err := p.ParseDDL(`
+create table a (id int primary key, b_id int, c_id int,
+	foreign key (b_id) references b (id),
+	constraint fk_c foreign key (c_id) references c (id));`)
+		assert.NoError(t, err)
+		assert.Equal(t, []*Index{
+			{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true},
+			{Name: "b_id", Columns: []string{"b_id"}},
+			{Name: "fk_c", Columns: []string{"c_id"}},
+		}, tables[0].Indexes)
+	})
+	t.Run("should drop table", func(t *testing.T) {
+		tables, err := p.ParseDDL(`
+create table a (id int);
+create table b (id int);
+drop table if exists a, c;
+create table c (id int);`)
+		assert.NoError(t, err)
+		assert.Len(t, tables, 2)
+		assert.Equal(t, "b", tables[0].TableName)
+		assert.Equal(t, "c", tables[1].TableName)
+	})
+	t.Run("create table if not exists", func(t *testing.T) {
+		tables, err := p.ParseDDL(`
+create table if not exists a (id int);
+create table if not exists a (id int, name text);
+`)
+		assert.NoError(t, err)
+		if assert.Len(t, tables, 1) {
+			assert.Len(t, tables[0].Columns, 1)
+		}
+	})
+	t.Run("views", func(t *testing.T) {
+		var buf bytes.Buffer
+		log.SetOutput(&buf)
+		defer log.SetOutput(os.Stderr)
+		tables, err := p.ParseDDL(`
+create table users (id int, name varchar(16) not null default 'a' comment 'Name', primary key (id));
+create table active_users_base (id int not null);
+create algorithm = merge definer = ` + "`root`@`localhost`" + ` sql security invoker view active_users as
+	select * from users where name <> '';
+create view user_names (user_id, user_name) as select distinct u.id, db.u.name from users as u;
+create view user_groups as select u.id from users u join groups g on 1 = 1;
+create view user_counts as select count(*) as count from users;
+create view old_users as select id from users;
+drop view old_users;
+create or replace view active_users as select id from active_users_base with check option;
+create definer = root procedure p() select 1;
+`)
+		assert.NoError(t, err)
+		var names []string
+		for _, t := range tables {
+			names = append(names, t.TableName)
+		}
+		assert.Equal(t, []string{"users", "active_users_base", "user_names", "active_users"}, names)
+		assert.Equal(t, &Table{
+			TableName: "user_names",
+			View:      true,
+			Columns: []*Column{
+				{FieldName: "user_id", FieldType: "int", FieldIndex: 0, GoFieldType: "int64", Key: str(""), Extra: str("")},
+				{
+					FieldName: "user_name", FieldType: "varchar(16)", FieldIndex: 1, GoFieldType: "string",
+					DefaultValue: str("a"), Key: str(""), Extra: str(""), Comment: "Name",
+				},
+			},
+		}, tables[2])
+		assert.True(t, tables[3].View)
+		assert.Equal(t, []*Column{
+			{FieldName: "id", FieldType: "int", FieldIndex: 0, GoFieldType: "int64", Key: str(""), Extra: str("")},
+		}, tables[3].Columns)
+		assert.Contains(t, buf.String(), "skipped view user_groups")
+		assert.Contains(t, buf.String(), "skipped view user_counts")
+	})
+	t.Run("types", func(t *testing.T) {
+		for src, expected := range map[string]string{
+			"INT(11)":               "int",
+			"int(10) unsigned":      "int unsigned",
+			"int(5) zerofill":       "int(5) unsigned zerofill",
+			"tinyint(1)":            "tinyint(1)",
+			"tinyint(4)":            "tinyint",
+			"double precision":      "double",
+			"character varying(10)": "varchar(10)",
+			"varchar(10) binary":    "varchar(10)",
+			"serial":                "bigint unsigned",
+			"bigint(20) signed":     "bigint",
+			"national char":         "char(1)",
+			"long varchar":          "mediumtext",
+		} {
+			t.Run(src, func(t *testing.T) {
+				tables, err := p.ParseDDL("create table t (c " + src + ")")
+				if assert.NoError(t, err) {
+					assert.Equal(t, expected, tables[0].Columns[0].FieldType)
+				}
+			})
+		}
+	})
+	t.Run("should return error", func(t *testing.T) {
+		for name, ddl := range map[string]string{
+			"unsupported statement":  "create table a (id int); alter table a add column b int",
+			"unknown type":           "create table a (id uuid)",
+			"duplicated table":       "create table a (id int); create table a (id int)",
+			"unknown table":          "create index i on a (id)",
+			"multiple primary keys":  "create table a (id int primary key, primary key (id))",
+			"syntax error":           "create table a (id int not not null)",
+			"unbalanced":             "create table a (id int",
+			"unterminated string":    "create table a (id int default 'a)",
+			"unterminated comment":   "create table a (id int) /*",
+			"unterminated quote":     "create table `a (id int)",
+			"generated column":       "create table a (id int, b int as (id + 1))",
+			"create table like":      "create table a like b",
+			"view of unknown table":  "create view v as select id from a",
+			"view of unknown column": "create table a (id int); create view v as select b from a",
+			"view of unknown alias":  "create table a (id int); create view v as select b.* from a",
+			"view columns mismatch":  "create table a (id int); create view v (a, b) as select id from a",
+			"view duplicate columns": "create table a (id int); create view v as select id, id from a",
+			"view replacing table":   "create table a (id int); create or replace view a as select id from a",
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := p.ParseDDL(ddl)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestDDLParser_ParseDDLFiles(t *testing.T) {
+	p := NewDDLParser()
+	t.Run("basic", func(t *testing.T) {
+		dir := t.TempDir()
+		a := filepath.Join(dir, "a.sql")
+		b := filepath.Join(dir, "b.sql")
+		// Statements don't continue across files even without the trailing semicolon
+		assert.NoError(t, os.WriteFile(a, []byte("create table a (id int)"), 0600))
+		assert.NoError(t, os.WriteFile(b, []byte("create index a_id on a (id);"), 0600))
+		tables, err := p.ParseDDLFiles(a, b)
+		assert.NoError(t, err)
+		assert.Equal(t, []*Index{{Name: "a_id", Columns: []string{"id"}}}, tables[0].Indexes)
+	})
+	t.Run("schema/model.sql", func(t *testing.T) {
+		tables, err := p.ParseDDLFiles("schema/model.sql")
+		assert.NoError(t, err)
+		var names []string
+		for _, t := range tables {
+			names = append(names, t.TableName)
+		}
+		assert.Equal(t, []string{"users", "user_groups", "group_users", "user_login_histories", "fields"}, names)
+	})
+	t.Run("should return error if file not found", func(t *testing.T) {
+		_, err := p.ParseDDLFiles("not_found.sql")
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,161 @@
+// Package decimal provides the exact decimal number type for DECIMAL columns.
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number kept in the string representation as MySQL returns,
+// so that no precision is lost by float conversion. The zero value is 0.
+type Decimal struct {
+	s string
+}
+
+var (
+	_ sql.Scanner              = (*Decimal)(nil)
+	_ driver.Valuer            = Decimal{}
+	_ json.Marshaler           = Decimal{}
+	_ json.Unmarshaler         = (*Decimal)(nil)
+	_ encoding.TextMarshaler   = Decimal{}
+	_ encoding.TextUnmarshaler = (*Decimal)(nil)
+)
+
+var decimalPat = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// New parses s as a decimal number, e.g. "-123.450".
+// Exponents, NaN and infinities are not accepted as DECIMAL columns can't hold them.
+func New(s string) (Decimal, error) {
+	if !decimalPat.MatchString(s) {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+	}
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	s = intPart
+	if hasFrac && fracPart != "" {
+		s += "." + fracPart
+	}
+	if neg && strings.Trim(s, "0.") != "" {
+		s = "-" + s
+	}
+	return Decimal{s: s}, nil
+}
+
+// MustNew is like New but panics if s is invalid.
+func MustNew(s string) Decimal {
+	d, err := New(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// FromInt returns the decimal of i.
+func FromInt(i int64) Decimal {
+	return Decimal{s: strconv.FormatInt(i, 10)}
+}
+
+// String returns the decimal in the normalized form.
+// Trailing zeros of the fraction are preserved as they represent the scale.
+func (d Decimal) String() string {
+	if d.s == "" {
+		return "0"
+	}
+	return d.s
+}
+
+// Rat returns the decimal as an exact rational number.
+func (d Decimal) Rat() *big.Rat {
+	r, _ := new(big.Rat).SetString(d.String())
+	return r
+}
+
+// Float64 returns the nearest float64 value of the decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Cmp compares d and o numerically, returning -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int {
+	return d.Rat().Cmp(o.Rat())
+}
+
+// Equal reports whether d and o are numerically equal regardless of their scales,
+// e.g. 1.5 equals 1.50.
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// Scan implements sql.Scanner.
+func (d *Decimal) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("unsupported type for decimal: %T", src)
+	}
+	parsed, err := New(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// MarshalJSON implements json.Marshaler. The decimal is encoded as a string not to lose precision.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both strings and numbers are accepted.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("invalid decimal: %s", data)
+		}
+		s = n.String()
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := New(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
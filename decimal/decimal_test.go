@@ -0,0 +1,104 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	for src, expected := range map[string]string{
+		"0":        "0",
+		"123.450":  "123.450",
+		"+1":       "1",
+		"-001.5":   "-1.5",
+		"-0.00":    "0.00",
+		".5":       "0.5",
+		"10.":      "10",
+		"00012345": "12345",
+	} {
+		t.Run(src, func(t *testing.T) {
+			d, err := New(src)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, d.String())
+		})
+	}
+	for _, src := range []string{"", "-", ".", "1e3", "NaN", "1.2.3", " 1", "0x10"} {
+		t.Run("invalid "+src, func(t *testing.T) {
+			_, err := New(src)
+			assert.EqualError(t, err, "invalid decimal: \""+src+"\"")
+		})
+	}
+	t.Run("MustNew", func(t *testing.T) {
+		assert.Equal(t, "1.5", MustNew("1.5").String())
+		assert.Panics(t, func() { MustNew("x") })
+	})
+}
+
+func TestDecimal(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		var d Decimal
+		assert.Equal(t, "0", d.String())
+		assert.True(t, d.Equal(FromInt(0)))
+	})
+	t.Run("Rat and Float64", func(t *testing.T) {
+		d := MustNew("-12.25")
+		assert.Equal(t, "-49/4", d.Rat().String())
+		assert.Equal(t, -12.25, d.Float64())
+	})
+	t.Run("Cmp and Equal", func(t *testing.T) {
+		assert.True(t, MustNew("1.5").Equal(MustNew("1.50")))
+		assert.Equal(t, -1, MustNew("0.1").Cmp(MustNew("0.2")))
+		assert.Equal(t, 1, FromInt(10).Cmp(MustNew("9.99999999999999999999")))
+	})
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	for name, v := range map[string][]any{
+		"[]byte":  {[]byte("12.50"), "12.50"},
+		"string":  {"12.50", "12.50"},
+		"int64":   {int64(12), "12"},
+		"float64": {12.5, "12.5"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var d Decimal
+			assert.NoError(t, d.Scan(v[0]))
+			assert.Equal(t, v[1], d.String())
+		})
+	}
+	t.Run("should return error for unsupported values", func(t *testing.T) {
+		var d Decimal
+		assert.EqualError(t, d.Scan(nil), "unsupported type for decimal: <nil>")
+		assert.EqualError(t, d.Scan("abc"), `invalid decimal: "abc"`)
+	})
+}
+
+func TestDecimal_Value(t *testing.T) {
+	v, err := MustNew("12.50").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "12.50", v)
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	data, err := json.Marshal(MustNew("12.50"))
+	assert.NoError(t, err)
+	assert.Equal(t, `"12.50"`, string(data))
+	for _, src := range []string{`"12.50"`, `12.50`} {
+		var d Decimal
+		assert.NoError(t, json.Unmarshal([]byte(src), &d))
+		assert.Equal(t, "12.50", d.String())
+	}
+	var d Decimal
+	assert.EqualError(t, json.Unmarshal([]byte(`true`), &d), "invalid decimal: true")
+	assert.EqualError(t, json.Unmarshal([]byte(`"x"`), &d), `invalid decimal: "x"`)
+}
+
+func TestDecimal_Text(t *testing.T) {
+	text, err := MustNew("-1.0").MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "-1.0", string(text))
+	var d Decimal
+	assert.NoError(t, d.UnmarshalText([]byte("3.14")))
+	assert.Equal(t, "3.14", d.String())
+}
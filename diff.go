@@ -0,0 +1,83 @@
+package exql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DiffField is a column compared by DiffUpdate of the model.
+type DiffField struct {
+	Column *Column
+	// Equal is the expression reporting whether the field is unchanged,
+	// e.g. "bytes.Equal(u.Payload, after.Payload)".
+	Equal string
+	// pkg is the package required by Equal, if any.
+	pkg string
+}
+
+// nullInnerTypes are the types of values of aliases in the null package.
+var nullInnerTypes = map[string]string{
+	"null.Uint64":  "uint64",
+	"null.Int64":   "int64",
+	"null.Bool":    "bool",
+	"null.Float64": "float64",
+	"null.Float32": "float32",
+	"null.Time":    "time.Time",
+	"null.String":  "string",
+	"null.Bytes":   "[]byte",
+	"null.JSON":    "json.RawMessage",
+	"null.Decimal": "decimal.Decimal",
+}
+
+var comparableTypes = []string{
+	"string", "bool",
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64",
+	"float32", "float64",
+}
+
+// DiffFields returns the columns of the Update struct with expressions comparing them
+// between the models named before and after.
+func (t *Table) DiffFields(before, after string) []*DiffField {
+	enums := map[string]bool{}
+	for _, e := range t.EnumTypes() {
+		enums[e.Name] = !e.Set
+	}
+	var ret []*DiffField
+	for _, c := range t.UpdateColumns() {
+		a, b := before+"."+c.GoName(), after+"."+c.GoName()
+		typ := c.GoFieldType
+		inner, nullable := nullInnerTypes[typ]
+		if strings.HasPrefix(typ, "null.Null[") && strings.HasSuffix(typ, "]") {
+			inner, nullable = strings.TrimSuffix(strings.TrimPrefix(typ, "null.Null["), "]"), true
+		}
+		f := &DiffField{Column: c}
+		if nullable {
+			var eq string
+			eq, f.pkg = equalExpr(inner, a+".V", b+".V", enums[inner])
+			f.Equal = fmt.Sprintf("%s.Valid == %s.Valid && (!%s.Valid || %s)", a, b, a, eq)
+		} else {
+			f.Equal, f.pkg = equalExpr(typ, a, b, enums[typ])
+		}
+		ret = append(ret, f)
+	}
+	return ret
+}
+
+// equalExpr returns the expression comparing a and b of the type, and the package it requires.
+// Byte slices are equal regardless of nil or empty, and types with Equal method by it.
+// json.RawMessage is compared byte-wise as well, so semantically equal JSON in different formats,
+// such as key orders and spaces, is reported as changed. It only costs a redundant update.
+func equalExpr(typ, a, b string, comparable bool) (string, string) {
+	switch {
+	case typ == "[]byte" || typ == "json.RawMessage":
+		return fmt.Sprintf("bytes.Equal(%s, %s)", a, b), "bytes"
+	case typ == "time.Time" || typ == "decimal.Decimal":
+		return fmt.Sprintf("%s.Equal(%s)", a, b), ""
+	case comparable || slices.Contains(comparableTypes, typ):
+		return fmt.Sprintf("%s == %s", a, b), ""
+	default:
+		return fmt.Sprintf("reflect.DeepEqual(%s, %s)", a, b), "reflect"
+	}
+}
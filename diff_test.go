@@ -0,0 +1,92 @@
+package exql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/null"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_DiffFields(t *testing.T) {
+	table := &Table{
+		TableName: "items",
+		Columns: []*Column{
+			{FieldName: "id", GoFieldType: "int64", Key: sql.NullString{String: "PRI", Valid: true}},
+			{FieldName: "name", GoFieldType: "string"},
+			{FieldName: "payload", GoFieldType: "json.RawMessage"},
+			{FieldName: "memo", GoFieldType: "null.Null[string]", Nullable: true},
+			{FieldName: "price", GoFieldType: "null.Decimal", Nullable: true},
+			{FieldName: "status", FieldType: "enum('a','b')", GoFieldType: "ItemsStatusEnum"},
+			{FieldName: "tags", FieldType: "set('a','b')", GoFieldType: "ItemsTagsSet"},
+			{FieldName: "level", GoFieldType: "*int16", Nullable: true},
+		},
+	}
+	var exprs []string
+	for _, f := range table.DiffFields("i", "after") {
+		exprs = append(exprs, f.Equal)
+	}
+	assert.Equal(t, []string{
+		"i.Name == after.Name",
+		"bytes.Equal(i.Payload, after.Payload)",
+		"i.Memo.Valid == after.Memo.Valid && (!i.Memo.Valid || i.Memo.V == after.Memo.V)",
+		"i.Price.Valid == after.Price.Valid && (!i.Price.Valid || i.Price.V.Equal(after.Price.V))",
+		"i.Status == after.Status",
+		"reflect.DeepEqual(i.Tags, after.Tags)",
+		"reflect.DeepEqual(i.Level, after.Level)",
+	}, exprs)
+}
+
+func TestGeneratedDiffUpdate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := &model.Fields{
+		Id:                1,
+		TextField:         "a",
+		TextNullField:     null.String{Null: sql.Null[string]{V: "stale"}},
+		BlobField:         nil,
+		JsonField:         json.RawMessage(`{"a":1}`),
+		DatetimeField:     now,
+		DatetimeNullField: null.New(now),
+	}
+	t.Run("nothing changed", func(t *testing.T) {
+		after := *before
+		after.Id = 2
+		after.TextNullField = null.String{}
+		after.BlobField = []byte{}
+		after.JsonField = json.RawMessage(`{"a":1}`)
+		after.DatetimeField = now.In(time.FixedZone("JST", 9*60*60))
+		after.DatetimeNullField = null.New(now.In(time.FixedZone("JST", 9*60*60)))
+		assert.Nil(t, before.DiffUpdate(&after))
+	})
+	t.Run("changed", func(t *testing.T) {
+		after := *before
+		after.TextField = "b"
+		after.TextNullField = null.New("stale")
+		after.JsonField = json.RawMessage(`{"a":2}`)
+		after.DatetimeNullField = null.Time{}
+		update := before.DiffUpdate(&after)
+		assert.Equal(t, model.NewUpdateFields().
+			SetTextField("b").
+			SetTextNullField(null.New("stale")).
+			SetJsonField(json.RawMessage(`{"a":2}`)).
+			SetNullDatetimeNullField(), update)
+	})
+	t.Run("json compared by bytes", func(t *testing.T) {
+		after := *before
+		after.JsonField = json.RawMessage(`{"a": 1}`)
+		update := before.DiffUpdate(&after)
+		assert.Equal(t, model.NewUpdateFields().SetJsonField(json.RawMessage(`{"a": 1}`)), update)
+	})
+	t.Run("ready for update", func(t *testing.T) {
+		update := (&model.Users{Id: 1, Name: "go", Age: 10}).DiffUpdate(&model.Users{Id: 1, Name: "exql", Age: 10})
+		q, err := QueryForUpdateModel(update, Where("id = ?", 1))
+		assert.NoError(t, err)
+		stmt, args, err := q.Query()
+		assert.NoError(t, err)
+		assert.Equal(t, "UPDATE `users` SET `name` = ? WHERE id = ?", stmt)
+		assert.Equal(t, []any{"exql", 1}, args)
+	})
+}
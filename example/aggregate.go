@@ -0,0 +1,42 @@
+package main
+
+import (
+	"log"
+
+	"github.com/loilo-inc/exql/v3"
+	"github.com/loilo-inc/exql/v3/model"
+)
+
+// UserWithGroups is a parent model that holds its groups.
+// Fields tagged with `has_many` are ignored on mapping and filled by AggregateRows.
+type UserWithGroups struct {
+	Id     int64               `exql:"column:id;primary"`
+	Name   string              `exql:"column:name"`
+	Groups []*model.UserGroups `exql:"has_many:user_groups"`
+}
+
+func AggregateOuterJoin(db exql.DB) {
+	query := `
+	SELECT users.id, users.name, user_groups.* FROM users
+	LEFT JOIN group_users ON group_users.user_id = users.id
+	LEFT JOIN user_groups ON user_groups.id = group_users.group_id
+	ORDER BY users.id`
+	rows, err := db.DB().Query(query)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	serialMapper := exql.NewSerialMapper(func(i int) string {
+		return "id"
+	})
+	// Users are deduplicated by the primary key (id).
+	// Groups are empty for the user that does not belong to any group.
+	users, err := exql.AggregateRows[UserWithGroups](rows, serialMapper)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	for _, user := range users {
+		log.Printf("%s: %d groups", user.Name, len(user.Groups))
+	}
+}
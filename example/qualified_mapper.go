@@ -0,0 +1,39 @@
+package main
+
+import (
+	"log"
+
+	"github.com/loilo-inc/exql/v3"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/query"
+)
+
+func MapQualified(db exql.DB) {
+	// Each column is aliased as `table__column`:
+	// SELECT `users`.`id` AS `users__id`, ..., `user_groups`.`id` AS `user_groups__id`, ...
+	q := query.New(`
+	SELECT :?, :? FROM users
+	LEFT JOIN group_users ON group_users.user_id = users.id
+	LEFT JOIN user_groups ON user_groups.id = group_users.group_id
+	WHERE users.id = ?`,
+		exql.MustQualifiedColumns(&model.Users{}),
+		exql.MustQualifiedColumns(&model.UserGroups{}),
+		1,
+	)
+	rows, err := db.Query(q)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	defer rows.Close()
+	// Columns are assigned to destinations by TableName(), regardless of their order.
+	qualifiedMapper := exql.NewQualifiedMapper()
+	for rows.Next() {
+		var user model.Users
+		var group *model.UserGroups // nil when the user does not belong to any group.
+		if err := qualifiedMapper.Map(rows, &user, &group); err != nil {
+			log.Fatal(err.Error())
+			return
+		}
+	}
+}
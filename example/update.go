@@ -20,6 +20,19 @@ func UpdateModel(db exql.DB) {
 	}
 }
 
+// Using setters of designated update struct
+func UpdateModelBySetters(db exql.DB) {
+	// UPDATE `users` SET `age` = ?,`name` = ? WHERE `id` = ?
+	// [3, GoGo, 1]
+	_, err := db.UpdateModel(
+		model.NewUpdateUsers().SetName("GoGo").SetAge(3),
+		exql.Where("id = ?", 1),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
 // With table name and key-value pairs
 func Update(db exql.DB) {
 	// UPDATE `users` SET `name` = `GoGo` WHERE `id` = ?
@@ -0,0 +1,346 @@
+package exql
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+)
+
+// factoryHelperFileName is the file of helpers shared by factories of tables.
+const factoryHelperFileName = "factory.go"
+
+var (
+	builtinFactoryTemplate       = template.Must(ParseModelTemplate("factory", factoryTemplate))
+	builtinFactoryHelperTemplate = template.Must(template.New("factory_helper").Parse(factoryHelperTemplate))
+)
+
+// maxIntValues are the maximum values of integer types by the prefix, signed and unsigned.
+var maxIntValues = map[string][2]int64{
+	"tiny":   {127, 255},
+	"small":  {32767, 65535},
+	"medium": {8388607, 16777215},
+	"":       {2147483647, 4294967295},
+}
+
+var (
+	charSizePat      = regexp.MustCompile(`^(?:var)?(?:char|binary)\((\d+)\)$`)
+	decimalDigitsPat = regexp.MustCompile(`^(?:decimal|numeric)\((\d+)(?:,(\d+))?\)`)
+)
+
+// wkbTypes are the WKB geometry types of spatial column types.
+var wkbTypes = map[string]int{
+	"geometry":           1,
+	"point":              1,
+	"linestring":         2,
+	"polygon":            3,
+	"multipoint":         4,
+	"multilinestring":    5,
+	"multipolygon":       6,
+	"geometrycollection": 7,
+	"geomcollection":     7,
+}
+
+// FactoryValue returns the Go expression of the test value of the column in factories, using seq,
+// the sequence number of the model. Values respect the range or the length of the column type,
+// and spatial columns get the valid geometry of the type, such as POINT(0 0).
+// It returns empty if the zero value is enough or the type is unknown, such as for nullable,
+// auto_increment, SET columns and types given by type mappings.
+func (c *Column) FactoryValue() string {
+	if c.Nullable || c.IsAutoIncrement() {
+		return ""
+	}
+	ft := c.FieldType
+	if values := c.EnumValues(); values != nil {
+		// Generated enum types and string accept untyped constants
+		if c.IsSet() || len(values) == 0 || strings.ContainsAny(c.GoFieldType, ".*[") {
+			return ""
+		}
+		return strconv.Quote(values[0])
+	}
+	if t, err := ParseType(ft, false); err != nil || t != c.GoFieldType {
+		return ""
+	}
+	switch c.GoFieldType {
+	case boolType:
+		return "true"
+	case uint64Type:
+		return "uint64(seq)"
+	case int64Type:
+		if yearPat.MatchString(ft) {
+			return "2000 + seq%100"
+		}
+		m := intPat.FindStringSubmatch(ft)
+		if m == nil {
+			return ""
+		}
+		limits, ok := maxIntValues[m[1]]
+		if !ok {
+			return "seq"
+		}
+		if strings.Contains(ft, "unsigned") {
+			return fmt.Sprintf("seq %% %d", limits[1])
+		}
+		return fmt.Sprintf("seq %% %d", limits[0])
+	case float32Type:
+		return "float32(seq)"
+	case float64Type:
+		return "float64(seq)"
+	case decimalType:
+		precision, scale := 10, 0
+		if m := decimalDigitsPat.FindStringSubmatch(ft); m != nil {
+			precision, _ = strconv.Atoi(m[1])
+			if m[2] != "" {
+				scale, _ = strconv.Atoi(m[2])
+			}
+		}
+		return fmt.Sprintf("seqDecimal(seq, %d)", precision-scale)
+	case timeType:
+		return "seqTime(seq)"
+	case strType:
+		if timePat.MatchString(ft) {
+			return `"12:00:00"`
+		}
+		return fmt.Sprintf("seqString(%q, seq, %d)", c.FieldName, c.factorySize())
+	case bytesType:
+		if bitPat.MatchString(ft) {
+			return "[]byte{1}"
+		} else if spatialPat.MatchString(ft) {
+			return fmt.Sprintf("wkbGeometry(%d)", wkbTypes[ft])
+		}
+		return fmt.Sprintf("[]byte(seqString(%q, seq, %d))", c.FieldName, c.factorySize())
+	case jsonType:
+		return `[]byte("{}")`
+	}
+	return ""
+}
+
+// factorySize is the max length of values of string and binary columns in factories.
+func (c *Column) factorySize() int {
+	if m := charSizePat.FindStringSubmatch(c.FieldType); m != nil {
+		size, _ := strconv.Atoi(m[1])
+		return size
+	}
+	// TINYTEXT and TINYBLOB
+	return 255
+}
+
+// FactoryFields returns the columns filled by factories.
+func (t *Table) FactoryFields() []*Column {
+	var ret []*Column
+	for _, c := range t.Columns {
+		if c.FactoryValue() != "" {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+type factoryTemplateData struct {
+	*ModelTemplateData
+	// FactoryPackage is the package name of factories.
+	FactoryPackage string
+	// ModelImport is the import declaration of the model package.
+	ModelImport string
+}
+
+// generateFactoryFiles generates factories of tables and their helpers into opts.FactoryDir.
+func generateFactoryFiles(tables []*Table, opts *GenerateOptions) ([]*modelFileOutput, error) {
+	pkg := filepath.Base(opts.FactoryDir)
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("factory_dir must be a directory with the valid package name: %s", opts.FactoryDir)
+	}
+	modelImportPath := opts.ModelImportPath
+	if modelImportPath == "" {
+		var err error
+		if modelImportPath, err = resolveImportPath(opts.OutDir); err != nil {
+			return nil, err
+		}
+	}
+	modelImport := fmt.Sprintf("import %q", modelImportPath)
+	if path.Base(modelImportPath) != opts.Package {
+		modelImport = fmt.Sprintf("import %s %q", opts.Package, modelImportPath)
+	}
+	helper := &bytes.Buffer{}
+	if err := builtinFactoryHelperTemplate.Execute(helper, pkg); err != nil {
+		return nil, err
+	}
+	outputs := []*modelFileOutput{{
+		path:   filepath.Join(opts.FactoryDir, factoryHelperFileName),
+		source: helper.Bytes(),
+	}}
+	for _, table := range tables {
+		data, err := table.templateData(opts.Package)
+		if err != nil {
+			return nil, err
+		}
+		buf := &bytes.Buffer{}
+		if err := builtinFactoryTemplate.Execute(buf, &factoryTemplateData{
+			ModelTemplateData: data,
+			FactoryPackage:    pkg,
+			ModelImport:       modelImport,
+		}); err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, &modelFileOutput{
+			path:   filepath.Join(opts.FactoryDir, strcase.ToSnake(table.TableName)+".go"),
+			source: buf.Bytes(),
+		})
+	}
+	return outputs, nil
+}
+
+var goModModulePat = regexp.MustCompile(`(?m)^module\s+"?([^\s"]+)"?`)
+
+// resolveImportPath returns the import path of the directory by go.mod in it or its parents.
+func resolveImportPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for d := abs; ; d = filepath.Dir(d) {
+		data, err := os.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			m := goModModulePat.FindSubmatch(data)
+			if m == nil {
+				return "", fmt.Errorf("no module directive in %s", filepath.Join(d, "go.mod"))
+			}
+			rel, err := filepath.Rel(d, abs)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(string(m[1]), filepath.ToSlash(rel)), nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+		if filepath.Dir(d) == d {
+			return "", fmt.Errorf("go.mod is not found for %s: set model_import_path", dir)
+		}
+	}
+}
+
+const factoryHelperTemplate = generatedFileHeader + `
+package {{.}}
+
+import "encoding/binary"
+import "fmt"
+import "math"
+import "sync/atomic"
+import "time"
+import "github.com/loilo-inc/exql/v3/decimal"
+
+var sequence atomic.Int64
+
+// nextSeq returns the next sequence number of models built by factories.
+func nextSeq() int64 {
+	return sequence.Add(1)
+}
+
+// seqString returns the string of prefix and seq within size bytes, keeping seq at the end.
+func seqString(prefix string, seq int64, size int) string {
+	s := fmt.Sprintf("%s%d", prefix, seq)
+	if len(s) > size {
+		s = s[len(s)-size:]
+	}
+	return s
+}
+
+// seqTime returns the time seq seconds after 2000-01-01 UTC.
+func seqTime(seq int64) time.Time {
+	return time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(seq) * time.Second)
+}
+
+// seqDecimal returns seq within digits of the integer part.
+func seqDecimal(seq int64, digits int) decimal.Decimal {
+	if digits < 18 {
+		limit := int64(1)
+		for i := 0; i < digits; i++ {
+			limit *= 10
+		}
+		seq %= limit
+	}
+	return decimal.FromInt(seq)
+}
+
+// wkbGeometry returns the geometry of the WKB type in the internal format of MySQL, SRID 0 followed by WKB:
+// POINT(0 0), LINESTRING(0 0,1 1), POLYGON((0 0,1 0,1 1,0 0)), their multi types of one element
+// or the empty GEOMETRYCOLLECTION.
+func wkbGeometry(typ uint32) []byte {
+	return append(binary.LittleEndian.AppendUint32(nil, 0), wkb(typ)...)
+}
+
+// wkb returns the WKB of the type in little endian.
+func wkb(typ uint32) []byte {
+	b := binary.LittleEndian.AppendUint32([]byte{1}, typ)
+	points := func(coords ...float64) {
+		for _, c := range coords {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c))
+		}
+	}
+	switch typ {
+	case 1:
+		points(0, 0)
+	case 2:
+		b = binary.LittleEndian.AppendUint32(b, 2)
+		points(0, 0, 1, 1)
+	case 3:
+		b = binary.LittleEndian.AppendUint32(b, 1)
+		b = binary.LittleEndian.AppendUint32(b, 4)
+		points(0, 0, 1, 0, 1, 1, 0, 0)
+	case 4, 5, 6:
+		b = binary.LittleEndian.AppendUint32(b, 1)
+		b = append(b, wkb(typ-3)...)
+	default:
+		b = binary.LittleEndian.AppendUint32(b, 0)
+	}
+	return b
+}
+`
+
+const factoryTemplate = generatedFileHeader + `
+package {{.FactoryPackage}}
+
+import "context"
+{{- if not .Table.View}}
+import "github.com/loilo-inc/exql/v3/iface"
+{{- end}}
+{{.ModelImport}}
+
+// New{{.Model}} returns {{.Package}}.{{.Model}} with test values in NOT NULL columns, modified by overrides in order.
+// Values are unique in the process as possible by the sequence number.
+func New{{.Model}}(overrides ...func(*{{.Package}}.{{.Model}})) *{{.Package}}.{{.Model}} {
+{{- with .Table.FactoryFields}}
+	seq := nextSeq()
+	m := &{{$.Package}}.{{$.Model}}{
+{{- range .}}
+		{{.GoName}}: {{.FactoryValue}},
+{{- end}}
+	}
+{{- else}}
+	m := &{{.Package}}.{{.Model}}{}
+{{- end}}
+	for _, o := range overrides {
+		o(m)
+	}
+	return m
+}
+{{- if not .Table.View}}
+
+// Insert{{.Model}} inserts {{.Package}}.{{.Model}} built by New{{.Model}} with overrides.
+func Insert{{.Model}}(ctx context.Context, saver iface.Saver, overrides ...func(*{{.Package}}.{{.Model}})) (*{{.Package}}.{{.Model}}, error) {
+	m := New{{.Model}}(overrides...)
+	if _, err := saver.InsertContext(ctx, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+{{- end}}
+`
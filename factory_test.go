@@ -0,0 +1,195 @@
+package exql
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/model/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumn_FactoryValue(t *testing.T) {
+	column := func(fieldType string) *Column {
+		goType, err := ParseType(fieldType, false)
+		assert.NoError(t, err)
+		return &Column{FieldName: "f", FieldType: fieldType, GoFieldType: goType}
+	}
+	for _, v := range []struct {
+		fieldType string
+		expected  string
+	}{
+		{"tinyint(1)", "true"},
+		{"tinyint(4)", "seq % 127"},
+		{"smallint(6) unsigned", "seq % 65535"},
+		{"int(11)", "seq % 2147483647"},
+		{"bigint(20)", "seq"},
+		{"bigint(20) unsigned", "uint64(seq)"},
+		{"year", "2000 + seq%100"},
+		{"float", "float32(seq)"},
+		{"double", "float64(seq)"},
+		{"decimal(10,2)", "seqDecimal(seq, 8)"},
+		{"decimal(5)", "seqDecimal(seq, 5)"},
+		{"decimal", "seqDecimal(seq, 10)"},
+		{"datetime", "seqTime(seq)"},
+		{"time", `"12:00:00"`},
+		{"char(10)", `seqString("f", seq, 10)`},
+		{"varchar(255)", `seqString("f", seq, 255)`},
+		{"text", `seqString("f", seq, 255)`},
+		{"varbinary(4)", `[]byte(seqString("f", seq, 4))`},
+		{"blob", `[]byte(seqString("f", seq, 255))`},
+		{"bit(8)", "[]byte{1}"},
+		{"json", `[]byte("{}")`},
+		{"enum('a','b')", `"a"`},
+		{"set('a','b')", ""},
+		{"geometry", "wkbGeometry(1)"},
+		{"point", "wkbGeometry(1)"},
+		{"polygon", "wkbGeometry(3)"},
+		{"multipolygon", "wkbGeometry(6)"},
+		{"geomcollection", "wkbGeometry(7)"},
+	} {
+		t.Run(v.fieldType, func(t *testing.T) {
+			assert.Equal(t, v.expected, column(v.fieldType).FactoryValue())
+		})
+	}
+	t.Run("generated enum type", func(t *testing.T) {
+		c := column("enum('a','b')")
+		c.GoFieldType = "UsersStatusEnum"
+		assert.Equal(t, `"a"`, c.FactoryValue())
+	})
+	t.Run("should skip nullable columns", func(t *testing.T) {
+		c := &Column{FieldName: "f", FieldType: "int(11)", GoFieldType: "null.Int64", Nullable: true}
+		assert.Equal(t, "", c.FactoryValue())
+	})
+	t.Run("should skip auto_increment columns", func(t *testing.T) {
+		c := column("int(11)")
+		c.Extra = sql.NullString{String: "auto_increment", Valid: true}
+		assert.Equal(t, "", c.FactoryValue())
+	})
+	t.Run("should skip mapped types", func(t *testing.T) {
+		c := column("bigint(20)")
+		c.GoFieldType = "types.UserID"
+		assert.Equal(t, "", c.FactoryValue())
+		c = column("enum('a','b')")
+		c.GoFieldType = "types.Status"
+		assert.Equal(t, "", c.FactoryValue())
+	})
+}
+
+func TestGenerateOptions_FactoryDir(t *testing.T) {
+	writeSchema := func(t *testing.T) string {
+		p := filepath.Join(t.TempDir(), "schema.sql")
+		assert.NoError(t, os.WriteFile(p, []byte(`create table users (
+    id int not null auto_increment,
+    name varchar(8) not null,
+    primary key (id)
+);`), 0600))
+		return p
+	}
+	t.Run("should resolve the model import path by go.mod", func(t *testing.T) {
+		root := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/app\n\ngo 1.22\n"), 0600))
+		outDir := filepath.Join(root, "entity")
+		err := NewDDLGenerator(writeSchema(t)).Generate(&GenerateOptions{
+			OutDir:     outDir,
+			Package:    "model",
+			FactoryDir: filepath.Join(root, "testutil", "factory"),
+		})
+		assert.NoError(t, err)
+		source, err := os.ReadFile(filepath.Join(root, "testutil", "factory", "users.go"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(source), `import model "example.com/app/entity"`)
+		assert.Contains(t, string(source), `Name: seqString("name", seq, 8),`)
+		assert.Contains(t, string(source), "func InsertUsers(")
+		_, err = os.Stat(filepath.Join(root, "testutil", "factory", "factory.go"))
+		assert.NoError(t, err)
+	})
+	t.Run("should error without go.mod", func(t *testing.T) {
+		dir := t.TempDir()
+		err := NewDDLGenerator(writeSchema(t)).Generate(&GenerateOptions{
+			OutDir:     filepath.Join(dir, "model"),
+			FactoryDir: filepath.Join(dir, "factory"),
+		})
+		assert.ErrorContains(t, err, "go.mod is not found")
+	})
+	t.Run("should error if the directory is not a package name", func(t *testing.T) {
+		dir := t.TempDir()
+		err := NewDDLGenerator(writeSchema(t)).Generate(&GenerateOptions{
+			OutDir:          filepath.Join(dir, "model"),
+			FactoryDir:      filepath.Join(dir, "test-factory"),
+			ModelImportPath: "example.com/app/model",
+		})
+		assert.ErrorContains(t, err, "factory_dir must be a directory with the valid package name")
+	})
+	t.Run("should not generate inserts for views", func(t *testing.T) {
+		table := &Table{
+			TableName: "active_users",
+			View:      true,
+			Columns:   []*Column{{FieldName: "name", FieldType: "varchar(255)", GoFieldType: "string"}},
+		}
+		files, err := generateFactoryFiles([]*Table{table}, &GenerateOptions{
+			Package:         "model",
+			FactoryDir:      "factory",
+			ModelImportPath: "example.com/app/model",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, files, 2)
+		assert.Equal(t, "factory/active_users.go", files[1].path)
+		assert.Contains(t, string(files[1].source), "func NewActiveUsers(")
+		assert.NotContains(t, string(files[1].source), "Insert")
+		assert.NotContains(t, string(files[1].source), "iface")
+	})
+}
+
+func TestGeneratedFactory(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		a, b := factory.NewUsers(), factory.NewUsers()
+		assert.NotEmpty(t, a.Name)
+		assert.NotEqual(t, a.Name, b.Name)
+		assert.Zero(t, a.Id)
+
+		f := factory.NewFields()
+		assert.LessOrEqual(t, len(f.CharFiledField), 10)
+		assert.Len(t, f.BinaryField, 4)
+		assert.Equal(t, model.FieldsEnumFieldEnum("a"), f.EnumField)
+		assert.JSONEq(t, "{}", string(f.JsonField))
+		assert.False(t, f.IntNullableField.Valid)
+	})
+	t.Run("overrides", func(t *testing.T) {
+		user := factory.NewUsers(func(u *model.Users) {
+			u.Name = "go"
+		}, func(u *model.Users) {
+			u.Age = u.Age + 1
+			u.Name += "!"
+		})
+		assert.Equal(t, "go!", user.Name)
+	})
+	t.Run("insert", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectExec("INSERT INTO `users` \\(`age`,`name`\\) VALUES \\(\\?,\\?\\)").
+			WithArgs(int64(10), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(3, 1))
+		user, err := factory.InsertUsers(context.Background(), NewSaver(db), func(u *model.Users) {
+			u.Age = 10
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), user.Id)
+		assert.Equal(t, int64(10), user.Age)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("should return error of insert", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectExec("INSERT INTO `users`").WillReturnError(sql.ErrConnDone)
+		user, err := factory.InsertUsers(context.Background(), NewSaver(db))
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, user)
+	})
+}
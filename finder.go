@@ -6,14 +6,6 @@ import (
 	"github.com/loilo-inc/exql/v3/query"
 )
 
-// Finder is an interface to execute select query and map rows into the destination.
-type Finder interface {
-	Find(q query.Query, destPtrOfStruct any) error
-	FindContext(ctx context.Context, q query.Query, destPtrOfStruct any) error
-	FindMany(q query.Query, destSlicePtrOfStruct any) error
-	FindManyContext(ctx context.Context, q query.Query, destSlicePtrOfStruct any) error
-}
-
 type finder struct {
 	ex Executor
 }
@@ -52,6 +44,23 @@ func (f *finder) FindManyContext(ctx context.Context, q query.Query, destSlicePt
 	return nil
 }
 
+// FindManyOrEmpty implements Finder
+func (f *finder) FindManyOrEmpty(q query.Query, destSlicePtrOfStruct any) error {
+	return f.FindManyOrEmptyContext(context.Background(), q, destSlicePtrOfStruct)
+}
+
+// FindManyOrEmptyContext implements Finder
+func (f *finder) FindManyOrEmptyContext(ctx context.Context, q query.Query, destSlicePtrOfStruct any) error {
+	if stmt, args, err := q.Query(); err != nil {
+		return err
+	} else if rows, err := f.ex.QueryContext(ctx, stmt, args...); err != nil {
+		return err
+	} else if err := MapRowsOrEmpty(rows, destSlicePtrOfStruct); err != nil {
+		return err
+	}
+	return nil
+}
+
 // NewFinder creates a new Finder with the given Executor.
 func NewFinder(ex Executor) Finder {
 	return newFinder(ex)
@@ -1,9 +1,13 @@
 package exql
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/iface"
+	"github.com/loilo-inc/exql/v3/mocks/mock_iface"
 	"github.com/loilo-inc/exql/v3/mocks/mock_query"
 	"github.com/loilo-inc/exql/v3/model"
 	"github.com/loilo-inc/exql/v3/query"
@@ -79,3 +83,86 @@ func TestFinder(t *testing.T) {
 		})
 	})
 }
+
+func TestFinder_FindManyOrEmpty(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("select \\* from users").WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "age"}).AddRow(1, "user1", 10))
+		var dest []*model.Users
+		err = newFinder(mockDb).FindManyOrEmpty(query.Q(`select * from users`), &dest)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.Users{{Id: 1, Name: "user1", Age: 10}}, dest)
+	})
+	t.Run("should set empty slice if no rows found", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("select \\* from users").WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "age"}))
+		var dest []*model.Users
+		err = newFinder(mockDb).FindManyOrEmpty(query.Q(`select * from users`), &dest)
+		assert.NoError(t, err)
+		assert.NotNil(t, dest)
+		assert.Empty(t, dest)
+	})
+	t.Run("should error if query is invalid", func(t *testing.T) {
+		q := mock_query.NewMockQuery(gomock.NewController(t))
+		q.EXPECT().Query().Return("", nil, fmt.Errorf("err"))
+		err := newFinder(nil).FindManyOrEmpty(q, nil)
+		assert.EqualError(t, err, "err")
+	})
+	t.Run("should error if query failed", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("select").WillReturnError(fmt.Errorf("err"))
+		err = newFinder(mockDb).FindManyOrEmpty(query.Q(`select`), nil)
+		assert.EqualError(t, err, "err")
+	})
+	t.Run("should error if mapping failed", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("select \\* from users").WillReturnRows(
+			sqlmock.NewRows([]string{"id"}))
+		var dest []model.Users
+		err = newFinder(mockDb).FindManyOrEmpty(query.Q(`select * from users`), &dest)
+		assert.ErrorIs(t, err, errMapManyDestination)
+	})
+}
+
+func TestFindManyOrEmptyContext(t *testing.T) {
+	q := query.Q(`select * from users`)
+	t.Run("EmptyFinder", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("select \\* from users").WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "age"}))
+		var dest []*model.Users
+		err = iface.FindManyOrEmptyContext(context.Background(), NewFinder(mockDb), q, &dest)
+		assert.NoError(t, err)
+		assert.NotNil(t, dest)
+		assert.Empty(t, dest)
+	})
+	t.Run("should fall back to FindManyContext", func(t *testing.T) {
+		finder := mock_iface.NewMockFinder(gomock.NewController(t))
+		var dest []*model.Users
+		finder.EXPECT().FindManyContext(gomock.Any(), q, &dest).Return(ErrRecordNotFound{})
+		err := iface.FindManyOrEmptyContext(context.Background(), finder, q, &dest)
+		assert.NoError(t, err)
+		assert.NotNil(t, dest)
+		assert.Empty(t, dest)
+	})
+	t.Run("should return error of FindManyContext", func(t *testing.T) {
+		finder := mock_iface.NewMockFinder(gomock.NewController(t))
+		var dest []*model.Users
+		finder.EXPECT().FindManyContext(gomock.Any(), q, &dest).Return(fmt.Errorf("err"))
+		err := iface.FindManyOrEmptyContext(context.Background(), finder, q, &dest)
+		assert.EqualError(t, err, "err")
+		assert.Nil(t, dest)
+	})
+}
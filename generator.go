@@ -1,28 +1,138 @@
 package exql
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 	"go/format"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/loilo-inc/exql/v3/internal/diff"
 )
 
 type Generator interface {
 	Generate(opts *GenerateOptions) error
 }
 type generator struct {
-	db *sql.DB
+	source schemaSource
 }
 type GenerateOptions struct {
-	OutDir  string
-	Package string
-	Exclude []string
+	// @default "model"
+	OutDir string `json:"out_dir"`
+	// @default "model"
+	Package string `json:"package"`
+	// Include is the list of glob patterns for table names to be generated.
+	// All tables are generated if it is empty.
+	Include []string `json:"include"`
+	// Exclude is the list of glob patterns for table names not to be generated.
+	// It matched exact names in earlier versions, so escape `*`, `?`, `[` and `\` in names by `\`.
+	Exclude []string `json:"exclude"`
 	// FileNameMap maps table names to output file names.
 	// Values must match [A-Za-z0-9_-]+.go.
-	FileNameMap map[string]string
+	FileNameMap map[string]string `json:"file_name_map"`
+	// TypeMappings overrides Go types of generated fields. See TypeMapping for the priority.
+	TypeMappings []TypeMapping `json:"type_mappings"`
+	// DryRun only logs generated file names without touching the filesystem.
+	DryRun bool `json:"dry_run"`
+	// Templates are the templates generating files for each table.
+	// Only the built-in model template is used if empty.
+	Templates []ModelTemplate `json:"templates"`
+	// UpdateKeys keeps primary key and auto_increment columns in Update structs.
+	// They are omitted by default not to update keys by accident.
+	UpdateKeys bool `json:"update_keys"`
+	// Repositories generates the repository interface of each model with the implementation by exql
+	// and the in-memory fake for tests, into "<table>_repository.go".
+	Repositories bool `json:"repositories"`
+	// FactoryDir generates test data factories of models into the directory, if set.
+	// The package name of factories is the base name of the directory.
+	FactoryDir string `json:"factory_dir"`
+	// ModelImportPath is the import path of generated models, used by factories.
+	// It is resolved by go.mod in OutDir or its parents if empty.
+	ModelImportPath string `json:"model_import_path"`
+	// SchemaSnapshot writes the SchemaSnapshot of all generated tables as JSON into the file, if set.
+	SchemaSnapshot string `json:"schema_snapshot"`
+	// JSONSchemaDir writes the JSON Schema document of each model into "<table>.schema.json" in the directory, if set.
+	JSONSchemaDir string `json:"json_schema_dir"`
+	// Check compares generated models with the files in OutDir without writing them.
+	// Generate returns ErrSchemaDrift if they differ.
+	Check bool `json:"check"`
+}
+
+// ErrSchemaDrift is returned by Generate in the check mode
+// if model files in OutDir are out of date with the database schema.
+type ErrSchemaDrift struct {
+	// Added is the list of model files that don't exist yet.
+	Added []string
+	// Changed is the list of model files whose contents differ.
+	Changed []string
+	// Stale is the list of generated files that no longer correspond to any table.
+	Stale []string
+	// Diff is the unified diff from the files on disk to generated models.
+	Diff string
+}
+
+func (e ErrSchemaDrift) Error() string {
+	return fmt.Sprintf("models are out of date: %d added, %d changed, %d stale",
+		len(e.Added), len(e.Changed), len(e.Stale))
+}
+
+// ModelTemplate is the text/template generating a file for each table.
+// The template receives ModelTemplateData, and can use the functions described in ParseModelTemplate.
+type ModelTemplate struct {
+	// Path is the path to the template file. The built-in model template is used if empty.
+	Path string `json:"path"`
+	// FileName is the template for the output file name executed with ModelTemplateData,
+	// e.g. "{{.Table.TableName}}_repository.go". It defaults to "<table>_<base name of Path>.go",
+	// or the model file name for the built-in template.
+	FileName string `json:"file_name"`
+}
+
+// parsedModelTemplate is ModelTemplate parsed. fileName is nil for the default file name.
+type parsedModelTemplate struct {
+	source   *template.Template
+	fileName *template.Template
+	// suffix is appended to the table name for the default file name
+	suffix string
+}
+
+func parseModelTemplates(opts *GenerateOptions) ([]*parsedModelTemplate, error) {
+	templates := opts.Templates
+	if len(templates) == 0 {
+		templates = []ModelTemplate{{}}
+	}
+	var ret []*parsedModelTemplate
+	for _, t := range templates {
+		p := &parsedModelTemplate{source: builtinModelTemplate}
+		if t.Path != "" {
+			text, err := os.ReadFile(t.Path)
+			if err != nil {
+				return nil, err
+			}
+			base := filepath.Base(t.Path)
+			if p.source, err = ParseModelTemplate(base, string(text)); err != nil {
+				return nil, err
+			}
+			p.suffix = "_" + strings.Split(base, ".")[0]
+		}
+		if t.FileName != "" {
+			var err error
+			if p.fileName, err = ParseModelTemplate("file_name", t.FileName); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, p)
+	}
+	if opts.Repositories {
+		ret = append(ret, &parsedModelTemplate{source: builtinRepositoryTemplate, suffix: repositoryFileSuffix})
+	}
+	return ret, nil
 }
 
 var safeModelFileNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+\.go$`)
@@ -32,12 +142,115 @@ type modelFileOutput struct {
 	source []byte
 }
 
+// formatted returns the source formatted by gofmt, or as is for files other than Go.
+func (o *modelFileOutput) formatted() ([]byte, error) {
+	if filepath.Ext(o.path) != ".go" {
+		return o.source, nil
+	}
+	return format.Source(o.source)
+}
+
 func NewGenerator(db *sql.DB) Generator {
-	return &generator{db: db}
+	return NewGeneratorWithParser(db, NewParser())
+}
+
+// NewGeneratorWithParser returns the generator reading tables by p, e.g. NewInformationSchemaParser.
+func NewGeneratorWithParser(db *sql.DB, p Parser) Generator {
+	return &generator{source: &dbSchemaSource{db: db, parser: p}}
+}
+
+// NewDDLGenerator returns the generator reading tables from CREATE TABLE statements in the files
+// instead of the database. See DDLParser for the supported statements.
+func NewDDLGenerator(paths ...string) Generator {
+	return &generator{source: &ddlSchemaSource{paths: paths}}
+}
+
+// schemaSource provides tables to be generated.
+type schemaSource interface {
+	tableNames() ([]string, error)
+	table(name string) (*Table, error)
+}
+
+type dbSchemaSource struct {
+	db     *sql.DB
+	parser Parser
+	// views are the names of views found by tableNames.
+	views map[string]bool
+}
+
+func (s *dbSchemaSource) tableNames() ([]string, error) {
+	rows, err := s.db.Query(`show full tables`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tables []string
+	s.views = map[string]bool{}
+	for rows.Next() {
+		var table, tableType string
+		if err := rows.Scan(&table, &tableType); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+		if tableType == "VIEW" {
+			s.views[table] = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+func (s *dbSchemaSource) table(name string) (*Table, error) {
+	table, err := s.parser.ParseTable(s.db, name)
+	if err != nil {
+		return nil, err
+	}
+	// Parsers may also tell views, e.g. by information_schema
+	table.View = table.View || s.views[name]
+	return table, nil
+}
+
+type ddlSchemaSource struct {
+	paths  []string
+	tables []*Table
+}
+
+func (s *ddlSchemaSource) tableNames() ([]string, error) {
+	tables, err := NewDDLParser().ParseDDLFiles(s.paths...)
+	if err != nil {
+		return nil, err
+	}
+	s.tables = tables
+	var names []string
+	for _, t := range tables {
+		names = append(names, t.TableName)
+	}
+	return names, nil
+}
+
+func (s *ddlSchemaSource) table(name string) (*Table, error) {
+	for _, t := range s.tables {
+		if t.TableName == name {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("table not found: %s", name)
 }
 
 func (d *generator) Generate(opts *GenerateOptions) error {
-	rows, err := d.db.Query(`show tables`)
+	if err := validateTablePatterns(opts); err != nil {
+		return err
+	}
+	if err := validateTypeMappings(opts.TypeMappings); err != nil {
+		return err
+	}
+	templates, err := parseModelTemplates(opts)
+	if err != nil {
+		return err
+	}
+	names, err := d.source.tableNames()
 	if err != nil {
 		return err
 	}
@@ -47,78 +260,162 @@ func (d *generator) Generate(opts *GenerateOptions) error {
 	if opts.Package == "" {
 		opts.Package = "model"
 	}
-	if _, err := os.Stat(opts.OutDir); os.IsNotExist(err) {
-		err := os.Mkdir(opts.OutDir, 0750)
-		if err != nil {
+	if !opts.DryRun && !opts.Check {
+		if _, err := os.Stat(opts.OutDir); os.IsNotExist(err) {
+			err := os.Mkdir(opts.OutDir, 0750)
+			if err != nil {
+				return err
+			}
+		} else if err != nil {
 			return err
 		}
-	} else if err != nil {
-		return err
 	}
-	defer rows.Close()
-	var tables []string
-	for rows.Next() {
-		var table string
-		if err := rows.Scan(&table); err != nil {
-			return err
+	var tables []*Table
+	for _, name := range names {
+		if !matchTable(opts, name) {
+			continue
 		}
-		for _, e := range opts.Exclude {
-			if e == table {
-				goto EOL
-			}
+		table, err := d.source.table(name)
+		if err != nil {
+			return err
 		}
+		applyEnumTypes(table)
+		applyTypeMappings(table, opts.TypeMappings)
+		table.UpdateKeys = opts.UpdateKeys
 		tables = append(tables, table)
-	EOL:
-	}
-	if err := rows.Err(); err != nil {
-		return err
 	}
+	linkRelations(tables)
 	var outputs []*modelFileOutput
 	seenPaths := map[string]string{}
 	for _, table := range tables {
-		output, err := d.generateModelFile(table, opts)
+		tableOutputs, err := generateModelFiles(table, templates, opts)
 		if err != nil {
 			return err
 		}
-		if prevTable, ok := seenPaths[output.path]; ok {
-			return fmt.Errorf("duplicate generated model file %q for tables %q and %q", output.path, prevTable, table)
+		for _, output := range tableOutputs {
+			if prevTable, ok := seenPaths[output.path]; ok {
+				return fmt.Errorf("duplicate generated model file %q for tables %q and %q", output.path, prevTable, table.TableName)
+			}
+			seenPaths[output.path] = table.TableName
+			outputs = append(outputs, output)
 		}
-		seenPaths[output.path] = table
+	}
+	if opts.FactoryDir != "" {
+		factoryOutputs, err := generateFactoryFiles(tables, opts)
+		if err != nil {
+			return err
+		}
+		for _, output := range factoryOutputs {
+			if _, ok := seenPaths[output.path]; ok {
+				return fmt.Errorf("duplicate generated factory file %q", output.path)
+			}
+			seenPaths[output.path] = ""
+			outputs = append(outputs, output)
+		}
+		if !opts.DryRun && !opts.Check {
+			if err := os.MkdirAll(opts.FactoryDir, 0750); err != nil {
+				return err
+			}
+		}
+	}
+	schemaOutputs, err := generateSchemaFiles(tables, opts)
+	if err != nil {
+		return err
+	}
+	for _, output := range schemaOutputs {
+		if _, ok := seenPaths[output.path]; ok {
+			return fmt.Errorf("duplicate generated schema file %q", output.path)
+		}
+		seenPaths[output.path] = ""
 		outputs = append(outputs, output)
 	}
+	if opts.JSONSchemaDir != "" && !opts.DryRun && !opts.Check {
+		if err := os.MkdirAll(opts.JSONSchemaDir, 0750); err != nil {
+			return err
+		}
+	}
+	if opts.Check {
+		return checkModelFiles(opts.OutDir, outputs)
+	}
 	for _, output := range outputs {
-		if err := writeModelFile(output); err != nil {
+		if opts.DryRun {
+			if _, err := output.formatted(); err != nil {
+				return err
+			}
+			log.Printf("would generate file: %s", output.path)
+		} else if err := writeModelFile(output); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (d *generator) generateModelFile(tableName string, opt *GenerateOptions) (*modelFileOutput, error) {
-	p := NewParser()
-	table, err := p.ParseTable(d.db, tableName)
-	if err != nil {
-		return nil, err
+func validateTablePatterns(opts *GenerateOptions) error {
+	for _, pattern := range append(append([]string{}, opts.Include...), opts.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid table pattern %q: %w", pattern, err)
+		}
 	}
-	modelFile, err := table.GenerateModelFile(opt.Package)
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+// matchTable reports whether the table is included and not excluded by the options.
+// Patterns are ensured valid by validateTablePatterns.
+func matchTable(opts *GenerateOptions, table string) bool {
+	for _, e := range opts.Exclude {
+		if ok, _ := path.Match(e, table); ok {
+			return false
+		}
 	}
-	outFileName := modelFile.Name
-	if mappedFileName, ok := opt.FileNameMap[tableName]; ok {
-		if err := validateMappedModelFileName(mappedFileName); err != nil {
+	if len(opts.Include) == 0 {
+		return true
+	}
+	for _, i := range opts.Include {
+		if ok, _ := path.Match(i, table); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func generateModelFiles(
+	table *Table,
+	templates []*parsedModelTemplate,
+	opt *GenerateOptions,
+) ([]*modelFileOutput, error) {
+	tableName := table.TableName
+	var outputs []*modelFileOutput
+	for _, tmpl := range templates {
+		source, err := table.GenerateFile(tmpl.source, opt.Package)
+		if err != nil {
 			return nil, err
 		}
-		outFileName = mappedFileName
+		outFileName := fmt.Sprintf("%s%s.go", strcase.ToSnake(tableName), tmpl.suffix)
+		if tmpl.fileName != nil {
+			name, err := table.GenerateFile(tmpl.fileName, opt.Package)
+			if err != nil {
+				return nil, err
+			}
+			if err := validateMappedModelFileName(string(name)); err != nil {
+				return nil, err
+			}
+			outFileName = string(name)
+		} else if mappedFileName, ok := opt.FileNameMap[tableName]; ok && tmpl.suffix == "" {
+			if err := validateMappedModelFileName(mappedFileName); err != nil {
+				return nil, err
+			}
+			outFileName = mappedFileName
+		}
+		outputs = append(outputs, &modelFileOutput{
+			path:   filepath.Join(opt.OutDir, outFileName),
+			source: source,
+		})
 	}
-	return &modelFileOutput{
-		path:   filepath.Join(opt.OutDir, outFileName),
-		source: modelFile.Source,
-	}, nil
+	return outputs, nil
 }
 
 func writeModelFile(output *modelFileOutput) error {
-	if fmted, err := format.Source(output.source); err != nil {
+	if fmted, err := output.formatted(); err != nil {
 		return err
 	} else if err := os.WriteFile(output.path, fmted, 0640); err != nil {
 		return err
@@ -127,6 +424,56 @@ func writeModelFile(output *modelFileOutput) error {
 	return nil
 }
 
+// generatedFileHeader is the first line of model files, used to find stale files.
+const generatedFileHeader = "// Code generated by exql. DO NOT EDIT."
+
+func checkModelFiles(outDir string, outputs []*modelFileOutput) error {
+	var drift ErrSchemaDrift
+	diffs := strings.Builder{}
+	generated := map[string]struct{}{}
+	for _, output := range outputs {
+		generated[output.path] = struct{}{}
+		fmted, err := output.formatted()
+		if err != nil {
+			return err
+		}
+		current, err := os.ReadFile(output.path)
+		if os.IsNotExist(err) {
+			drift.Added = append(drift.Added, output.path)
+			diffs.WriteString(diff.Unified("", output.path, "", string(fmted)))
+		} else if err != nil {
+			return err
+		} else if !bytes.Equal(current, fmted) {
+			drift.Changed = append(drift.Changed, output.path)
+			diffs.WriteString(diff.Unified(output.path, output.path, string(current), string(fmted)))
+		}
+	}
+	entries, err := os.ReadDir(outDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, e := range entries {
+		p := filepath.Join(outDir, e.Name())
+		if _, ok := generated[p]; ok || e.IsDir() || filepath.Ext(p) != ".go" {
+			continue
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if !bytes.HasPrefix(content, []byte(generatedFileHeader+"\n")) {
+			continue
+		}
+		drift.Stale = append(drift.Stale, p)
+		diffs.WriteString(diff.Unified(p, "", string(content), ""))
+	}
+	if len(drift.Added)+len(drift.Changed)+len(drift.Stale) == 0 {
+		return nil
+	}
+	drift.Diff = diffs.String()
+	return drift
+}
+
 func validateMappedModelFileName(name string) error {
 	if !safeModelFileNamePattern.MatchString(name) {
 		return fmt.Errorf("invalid model file name %q: must match [A-Za-z0-9_-]+.go", name)
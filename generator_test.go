@@ -81,9 +81,9 @@ func TestGenerator_Generate(t *testing.T) {
 		assert.NoError(t, err)
 		defer mockDb.Close()
 
-		mock.ExpectQuery(`show tables`).WillReturnRows(
-			sqlmock.NewRows([]string{"tables"}).
-				AddRow("users").
+		mock.ExpectQuery(`show full tables`).WillReturnRows(
+			sqlmock.NewRows([]string{"tables", "Table_type"}).
+				AddRow("users", "BASE TABLE").
 				RowError(0, fmt.Errorf("err")))
 
 		dir := t.TempDir()
@@ -99,9 +99,9 @@ func TestGenerator_Generate(t *testing.T) {
 		assert.NoError(t, err)
 		defer mockDb.Close()
 
-		mock.ExpectQuery(`show tables`).WillReturnRows(
-			sqlmock.NewRows([]string{"tables"}).AddRow("users"))
-		mock.ExpectQuery("show columns from `users`").WillReturnError(fmt.Errorf("columns err"))
+		mock.ExpectQuery(`show full tables`).WillReturnRows(
+			sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow("users", "BASE TABLE"))
+		mock.ExpectQuery("show full columns from `users`").WillReturnError(fmt.Errorf("columns err"))
 
 		dir := t.TempDir()
 		assert.EqualError(t, NewGenerator(mockDb).
@@ -118,11 +118,12 @@ func TestGenerator_Generate_EscapesTableNameGoLiteral(t *testing.T) {
 	defer mockDb.Close()
 
 	table := `x";func init(){panic(1)};var _="`
-	mock.ExpectQuery(`show tables`).WillReturnRows(sqlmock.NewRows([]string{"tables"}).AddRow(table))
-	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("show columns from `%s`", table))).WillReturnRows(
-		sqlmock.NewRows([]string{"Field", "Type", "Null", "Key", "Default", "Extra"}).
-			AddRow("id", "int(11)", "NO", "PRI", nil, ""),
+	mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow(table, "BASE TABLE"))
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("show full columns from `%s`", table))).WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
 	)
+	expectTableStatus(mock, table, "BASE TABLE")
 
 	dir := t.TempDir()
 	err = NewGenerator(mockDb).Generate(&GenerateOptions{OutDir: dir, Package: "dist"})
@@ -145,11 +146,12 @@ func TestGenerator_Generate_UsesFileNameMap(t *testing.T) {
 	defer mockDb.Close()
 
 	table := "evil/foo"
-	mock.ExpectQuery(`show tables`).WillReturnRows(sqlmock.NewRows([]string{"tables"}).AddRow(table))
-	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("show columns from `%s`", table))).WillReturnRows(
-		sqlmock.NewRows([]string{"Field", "Type", "Null", "Key", "Default", "Extra"}).
-			AddRow("id", "int(11)", "NO", "PRI", nil, ""),
+	mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow(table, "BASE TABLE"))
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("show full columns from `%s`", table))).WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
 	)
+	expectTableStatus(mock, table, "BASE TABLE")
 
 	dir := t.TempDir()
 	var logBuf bytes.Buffer
@@ -185,19 +187,21 @@ func TestGenerator_Generate_ReturnsErrorForDuplicateFileNames(t *testing.T) {
 	assert.NoError(t, err)
 	defer mockDb.Close()
 
-	mock.ExpectQuery(`show tables`).WillReturnRows(
-		sqlmock.NewRows([]string{"tables"}).
-			AddRow("users").
-			AddRow("user_groups"),
+	mock.ExpectQuery(`show full tables`).WillReturnRows(
+		sqlmock.NewRows([]string{"tables", "Table_type"}).
+			AddRow("users", "BASE TABLE").
+			AddRow("user_groups", "BASE TABLE"),
 	)
-	mock.ExpectQuery("show columns from `users`").WillReturnRows(
-		sqlmock.NewRows([]string{"Field", "Type", "Null", "Key", "Default", "Extra"}).
-			AddRow("id", "int(11)", "NO", "PRI", nil, ""),
+	mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
 	)
-	mock.ExpectQuery("show columns from `user_groups`").WillReturnRows(
-		sqlmock.NewRows([]string{"Field", "Type", "Null", "Key", "Default", "Extra"}).
-			AddRow("id", "int(11)", "NO", "PRI", nil, ""),
+	expectTableStatus(mock, "users", "BASE TABLE")
+	mock.ExpectQuery("show full columns from `user_groups`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
 	)
+	expectTableStatus(mock, "user_groups", "BASE TABLE")
 
 	dir := t.TempDir()
 	err = NewGenerator(mockDb).Generate(&GenerateOptions{
@@ -223,11 +227,12 @@ func TestGenerator_Generate_PropagatesWriteError(t *testing.T) {
 	defer mockDb.Close()
 
 	table := "users"
-	mock.ExpectQuery(`show tables`).WillReturnRows(sqlmock.NewRows([]string{"tables"}).AddRow(table))
-	mock.ExpectQuery("show columns from `users`").WillReturnRows(
-		sqlmock.NewRows([]string{"Field", "Type", "Null", "Key", "Default", "Extra"}).
-			AddRow("id", "int(11)", "NO", "PRI", nil, ""),
+	mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow(table, "BASE TABLE"))
+	mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
 	)
+	expectTableStatus(mock, "users", "BASE TABLE")
 
 	dir := t.TempDir()
 	err = os.Mkdir(filepath.Join(dir, "users.go"), 0750)
@@ -277,3 +282,359 @@ func TestValidateMappedModelFileName(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerator_Generate_FiltersTablesByPatterns(t *testing.T) {
+	mockDb, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDb.Close()
+
+	mock.ExpectQuery(`show full tables`).WillReturnRows(
+		sqlmock.NewRows([]string{"tables", "Table_type"}).
+			AddRow("users", "BASE TABLE").
+			AddRow("user_groups", "BASE TABLE").
+			AddRow("user_login_histories", "BASE TABLE").
+			AddRow("fields", "BASE TABLE"),
+	)
+	mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
+	)
+	expectTableStatus(mock, "users", "BASE TABLE")
+	mock.ExpectQuery("show full columns from `user_groups`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
+	)
+	expectTableStatus(mock, "user_groups", "BASE TABLE")
+
+	dir := t.TempDir()
+	err = NewGenerator(mockDb).Generate(&GenerateOptions{
+		OutDir:  dir,
+		Package: "dist",
+		Include: []string{"user*"},
+		Exclude: []string{"*_histories"},
+	})
+	assert.NoError(t, err)
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	var files []string
+	for _, e := range entries {
+		files = append(files, e.Name())
+	}
+	assert.ElementsMatch(t, []string{"users.go", "user_groups.go"}, files)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchTable_EscapedExclude(t *testing.T) {
+	opts := &GenerateOptions{Exclude: []string{`logs\*`}}
+	assert.False(t, matchTable(opts, "logs*"))
+	assert.True(t, matchTable(opts, "logs_2024"))
+}
+
+func TestGenerator_Generate_ReturnsErrorForInvalidPattern(t *testing.T) {
+	mockDb, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDb.Close()
+
+	err = NewGenerator(mockDb).Generate(&GenerateOptions{
+		OutDir:  t.TempDir(),
+		Exclude: []string{"users["},
+	})
+	assert.EqualError(t, err, `invalid table pattern "users[": syntax error in pattern`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGenerator_Generate_DryRun(t *testing.T) {
+	mockDb, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDb.Close()
+
+	mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow("users", "BASE TABLE"))
+	mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
+	)
+	expectTableStatus(mock, "users", "BASE TABLE")
+
+	var logBuf bytes.Buffer
+	oldLogOutput := log.Writer()
+	oldLogFlags := log.Flags()
+	log.SetOutput(&logBuf)
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(oldLogOutput)
+		log.SetFlags(oldLogFlags)
+	})
+
+	dir := filepath.Join(t.TempDir(), "output")
+	err = NewGenerator(mockDb).Generate(&GenerateOptions{
+		OutDir:  dir,
+		Package: "dist",
+		DryRun:  true,
+	})
+	assert.NoError(t, err)
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, fmt.Sprintf("would generate file: %s\n", filepath.Join(dir, "users.go")), logBuf.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGenerator_Generate_Views(t *testing.T) {
+	mockDb, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDb.Close()
+
+	mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).
+		AddRow("users", "BASE TABLE").
+		AddRow("active_users", "VIEW"))
+	mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
+	)
+	expectTableStatus(mock, "users", "BASE TABLE")
+	mock.ExpectQuery("show full columns from `active_users`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "", nil, "", "select,insert,update,references", "").
+			AddRow("name", "varchar(255)", "utf8mb4_0900_ai_ci", "YES", "", nil, "", "select,insert,update,references", ""),
+	)
+	expectTableStatus(mock, "active_users", "VIEW")
+
+	dir := t.TempDir()
+	err = NewGenerator(mockDb).Generate(&GenerateOptions{OutDir: dir, Package: "dist", Repositories: true})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	users, err := os.ReadFile(filepath.Join(dir, "users.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(users), "type UpdateUsers struct {")
+	assert.NotContains(t, string(users), "ReadOnly")
+
+	view, err := os.ReadFile(filepath.Join(dir, "active_users.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(view), "type ActiveUsers struct {")
+	assert.Contains(t, string(view), "ReadOnly:   true,")
+	assert.NotContains(t, string(view), "UpdateActiveUsers")
+
+	repo, err := os.ReadFile(filepath.Join(dir, "active_users_repository.go"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(repo), "Insert(")
+	assert.Contains(t, string(repo), "func NewFakeActiveUsersRepository(records ...*ActiveUsers) *FakeActiveUsersRepository {")
+}
+
+func TestGenerator_Generate_AppliesTypeMappings(t *testing.T) {
+	mockDb, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDb.Close()
+
+	mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow("users", "BASE TABLE"))
+	mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+		sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+			AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", "").
+			AddRow("age", "int(11)", nil, "NO", "", nil, "", "select,insert,update,references", ""),
+	)
+	expectTableStatus(mock, "users", "BASE TABLE")
+
+	dir := t.TempDir()
+	err = NewGenerator(mockDb).Generate(&GenerateOptions{
+		OutDir:  dir,
+		Package: "dist",
+		TypeMappings: []TypeMapping{
+			{Column: "users.age", GoType: "uint8"},
+			{Column: "groups.id", GoType: "string"},
+		},
+	})
+	assert.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(dir, "users.go"))
+	assert.NoError(t, err)
+	assert.Regexp(t, `Id\s+int64\s+`, string(content))
+	assert.Regexp(t, `Age\s+uint8\s+`, string(content))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGenerator_Generate_Check(t *testing.T) {
+	generate := func(t *testing.T, opts *GenerateOptions) error {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow("users", "BASE TABLE"))
+		mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+			sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+				AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", ""),
+		)
+		expectTableStatus(mock, "users", "BASE TABLE")
+		err = NewGenerator(mockDb).Generate(opts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		return err
+	}
+	t.Run("up to date", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, generate(t, &GenerateOptions{OutDir: dir, Package: "dist"}))
+		// Non-generated files are not stale
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "custom.go"), []byte("package dist\n"), 0640))
+		assert.NoError(t, generate(t, &GenerateOptions{OutDir: dir, Package: "dist", Check: true}))
+	})
+	t.Run("added", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "output")
+		err := generate(t, &GenerateOptions{OutDir: dir, Package: "dist", Check: true})
+		var drift ErrSchemaDrift
+		if assert.ErrorAs(t, err, &drift) {
+			assert.Equal(t, []string{filepath.Join(dir, "users.go")}, drift.Added)
+			assert.Empty(t, drift.Changed)
+			assert.Empty(t, drift.Stale)
+			assert.Contains(t, drift.Diff, "--- /dev/null\n+++ "+filepath.Join(dir, "users.go"))
+		}
+		assert.EqualError(t, err, "models are out of date: 1 added, 0 changed, 0 stale")
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("changed and stale", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, generate(t, &GenerateOptions{OutDir: dir, Package: "dist"}))
+		usersFile := filepath.Join(dir, "users.go")
+		content, err := os.ReadFile(usersFile)
+		assert.NoError(t, err)
+		edited := bytes.Replace(content, []byte("int64"), []byte("int32"), 1)
+		assert.NoError(t, os.WriteFile(usersFile, edited, 0640))
+		staleFile := filepath.Join(dir, "groups.go")
+		assert.NoError(t, os.WriteFile(staleFile, []byte("// Code generated by exql. DO NOT EDIT.\npackage dist\n"), 0640))
+
+		err = generate(t, &GenerateOptions{OutDir: dir, Package: "dist", Check: true})
+		var drift ErrSchemaDrift
+		if assert.ErrorAs(t, err, &drift) {
+			assert.Empty(t, drift.Added)
+			assert.Equal(t, []string{usersFile}, drift.Changed)
+			assert.Equal(t, []string{staleFile}, drift.Stale)
+			assert.Contains(t, drift.Diff, "-\tId int32")
+			assert.Contains(t, drift.Diff, "+\tId int64")
+			assert.Contains(t, drift.Diff, "--- "+staleFile+"\n+++ /dev/null")
+		}
+		// Files are left as it is
+		current, err := os.ReadFile(usersFile)
+		assert.NoError(t, err)
+		assert.Equal(t, edited, current)
+		_, err = os.Stat(staleFile)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDDLGenerator_Generate(t *testing.T) {
+	t.Run("should generate the same models as the database", func(t *testing.T) {
+		dir := t.TempDir()
+		err := NewDDLGenerator("schema/model.sql").Generate(&GenerateOptions{
+			OutDir:          dir,
+			Repositories:    true,
+			FactoryDir:      filepath.Join(dir, "factory"),
+			ModelImportPath: "github.com/loilo-inc/exql/v3/model",
+		})
+		assert.NoError(t, err)
+		for _, sub := range []string{"", "factory"} {
+			entries, err := os.ReadDir(filepath.Join("model", sub))
+			assert.NoError(t, err)
+			for _, e := range entries {
+				if e.IsDir() {
+					continue
+				}
+				expected, err := os.ReadFile(filepath.Join("model", sub, e.Name()))
+				assert.NoError(t, err)
+				actual, err := os.ReadFile(filepath.Join(dir, sub, e.Name()))
+				assert.NoError(t, err)
+				assert.Equal(t, string(expected), string(actual), "file: %s", filepath.Join(sub, e.Name()))
+			}
+		}
+	})
+	t.Run("should return error if file not found", func(t *testing.T) {
+		err := NewDDLGenerator("schema/not_found.sql").Generate(&GenerateOptions{OutDir: t.TempDir()})
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestGenerator_Generate_Templates(t *testing.T) {
+	generate := func(t *testing.T, opts *GenerateOptions) error {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery(`show full tables`).WillReturnRows(sqlmock.NewRows([]string{"tables", "Table_type"}).AddRow("user_groups", "BASE TABLE"))
+		mock.ExpectQuery("show full columns from `user_groups`").WillReturnRows(
+			sqlmock.NewRows([]string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}).
+				AddRow("id", "int(11)", nil, "NO", "PRI", nil, "", "select,insert,update,references", "").
+				AddRow("name", "varchar(255)", "utf8mb4_0900_ai_ci", "NO", "", nil, "", "select,insert,update,references", ""),
+		)
+		expectTableStatus(mock, "user_groups", "BASE TABLE")
+		return NewGenerator(mockDb).Generate(opts)
+	}
+	writeTemplate := func(t *testing.T, name, text string) string {
+		p := filepath.Join(t.TempDir(), name)
+		assert.NoError(t, os.WriteFile(p, []byte(text), 0600))
+		return p
+	}
+	t.Run("multiple templates", func(t *testing.T) {
+		columns := writeTemplate(t, "columns.go.tmpl", `package {{.Package}}
+
+// {{.Model}}Columns are columns of {{quote .Table.TableName}}.
+var {{.Model}}Columns = []string{
+{{- range .Table.Columns}}
+	{{quote .FieldName}}, // {{camel .FieldName}} {{.GoFieldType}}
+{{- end}}
+}
+`)
+		named := writeTemplate(t, "named.tmpl", "package {{.Package}}\n\nconst {{lowerCamel .Table.TableName}}Snake = {{quote (snake .Model)}}\n")
+		dir := t.TempDir()
+		err := generate(t, &GenerateOptions{
+			OutDir:  dir,
+			Package: "dist",
+			Templates: []ModelTemplate{
+				{},
+				{Path: columns},
+				{Path: named, FileName: "{{.ModelLower}}_named.go"},
+			},
+		})
+		assert.NoError(t, err)
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		var files []string
+		for _, e := range entries {
+			files = append(files, e.Name())
+		}
+		assert.ElementsMatch(t, []string{"user_groups.go", "user_groups_columns.go", "userGroups_named.go"}, files)
+		content, err := os.ReadFile(filepath.Join(dir, "user_groups_columns.go"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "var UserGroupsColumns = []string{\n\t\"id\",   // Id int64\n\t\"name\", // Name string\n}")
+		content, err = os.ReadFile(filepath.Join(dir, "userGroups_named.go"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), `const userGroupsSnake = "user_groups"`)
+	})
+	t.Run("should return error if template is invalid", func(t *testing.T) {
+		for name, tmpl := range map[string]ModelTemplate{
+			"not found":       {Path: filepath.Join(t.TempDir(), "not_found.tmpl")},
+			"parse error":     {Path: writeTemplate(t, "a.tmpl", "{{.Model")},
+			"file name error": {FileName: "{{.Model"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// Templates are parsed before querying the database
+				err := NewGenerator(nil).Generate(&GenerateOptions{OutDir: t.TempDir(), Templates: []ModelTemplate{tmpl}})
+				assert.Error(t, err)
+			})
+		}
+	})
+	t.Run("should return error if template execution fails", func(t *testing.T) {
+		err := generate(t, &GenerateOptions{
+			OutDir:    t.TempDir(),
+			Templates: []ModelTemplate{{Path: writeTemplate(t, "b.tmpl", "{{.Unknown}}")}},
+		})
+		assert.ErrorContains(t, err, "can't evaluate field Unknown")
+	})
+	t.Run("should return error if file name is invalid", func(t *testing.T) {
+		err := generate(t, &GenerateOptions{
+			OutDir:    t.TempDir(),
+			Templates: []ModelTemplate{{FileName: "../{{.Model}}.go"}},
+		})
+		assert.EqualError(t, err, `invalid model file name "../UserGroups.go": must match [A-Za-z0-9_-]+.go`)
+	})
+}
+
+// expectTableStatus expects queries of the comment, the type and indexes of the table by the default parser.
+func expectTableStatus(mock sqlmock.Sqlmock, table, tableType string) {
+	mock.ExpectQuery("from information_schema.tables").WithArgs(table).WillReturnRows(
+		sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("", tableType))
+	mock.ExpectQuery("from information_schema.statistics").WithArgs(table).WillReturnRows(
+		sqlmock.NewRows([]string{"index_name", "non_unique", "column_name"}))
+}
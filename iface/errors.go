@@ -0,0 +1,8 @@
+package iface
+
+// ErrRecordNotFound is returned when no rows are found for the destination.
+type ErrRecordNotFound struct{}
+
+func (e ErrRecordNotFound) Error() string {
+	return "record not found"
+}
@@ -0,0 +1,44 @@
+package iface
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/loilo-inc/exql/v3/query"
+)
+
+// Finder is an interface to execute select query and map rows into the destination.
+type Finder interface {
+	Find(q query.Query, destPtrOfStruct any) error
+	FindContext(ctx context.Context, q query.Query, destPtrOfStruct any) error
+	FindMany(q query.Query, destSlicePtrOfStruct any) error
+	FindManyContext(ctx context.Context, q query.Query, destSlicePtrOfStruct any) error
+}
+
+// EmptyFinder is an optional interface of Finder to set an empty slice into the destination
+// instead of returning ErrRecordNotFound. Finder, DB and Tx made by exql implement it.
+type EmptyFinder interface {
+	// FindManyOrEmpty is same as FindMany except that it sets an empty slice
+	// into the destination instead of returning ErrRecordNotFound.
+	FindManyOrEmpty(q query.Query, destSlicePtrOfStruct any) error
+	FindManyOrEmptyContext(ctx context.Context, q query.Query, destSlicePtrOfStruct any) error
+}
+
+// FindManyOrEmptyContext calls FindManyOrEmptyContext of the finder if it implements EmptyFinder.
+// Otherwise, it calls FindManyContext and sets an empty slice into the destination on ErrRecordNotFound.
+func FindManyOrEmptyContext(ctx context.Context, finder Finder, q query.Query, destSlicePtrOfStruct any) error {
+	if f, ok := finder.(EmptyFinder); ok {
+		return f.FindManyOrEmptyContext(ctx, q, destSlicePtrOfStruct)
+	}
+	err := finder.FindManyContext(ctx, q, destSlicePtrOfStruct)
+	if !errors.Is(err, ErrRecordNotFound{}) {
+		return err
+	}
+	dest := reflect.ValueOf(destSlicePtrOfStruct)
+	if dest.Kind() != reflect.Pointer || dest.IsNil() || dest.Elem().Kind() != reflect.Slice {
+		return err
+	}
+	dest.Elem().Set(reflect.MakeSlice(dest.Elem().Type(), 0, 0))
+	return nil
+}
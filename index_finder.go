@@ -0,0 +1,177 @@
+package exql
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// IndexFinder is the finder function generated for an index of the table.
+type IndexFinder struct {
+	// Name is the function name, e.g. FindUsersById.
+	Name string
+	// Method is the method name of repositories, e.g. FindById.
+	Method string
+	// Unique reports whether the function finds one model by the primary or a unique key.
+	Unique  bool
+	Primary bool
+	// Columns are the indexed columns in the index order.
+	Columns []*Column
+}
+
+// reservedParamNames are the identifiers used in the bodies of generated finders and repositories,
+// including the names of imported packages.
+var reservedParamNames = []string{
+	"ctx", "finder", "dest", "err", "record", "update", "where", "r", "i", "c", "ret",
+	"bytes", "context", "decimal", "driver", "fmt", "iface", "json", "meta", "null", "query", "reflect", "strings", "sync", "time",
+}
+
+// ParamName is the name of the parameter for the column.
+func (f *IndexFinder) ParamName(c *Column) string {
+	name := strcase.ToLowerCamel(c.FieldName)
+	if name == "" || token.IsKeyword(name) || slices.Contains(reservedParamNames, name) {
+		name += "Value"
+	}
+	return name
+}
+
+// Params is the parameter list of the function following the finder, e.g. "id int64".
+func (f *IndexFinder) Params() string {
+	var params []string
+	for _, c := range f.Columns {
+		params = append(params, fmt.Sprintf("%s %s", f.ParamName(c), c.GoFieldType))
+	}
+	return strings.Join(params, ", ")
+}
+
+// Args is the argument list passing the parameters, e.g. "id, name".
+func (f *IndexFinder) Args() string {
+	var args []string
+	for _, c := range f.Columns {
+		args = append(args, f.ParamName(c))
+	}
+	return strings.Join(args, ", ")
+}
+
+// Match is the Go expression reporting whether the model v matches the parameters.
+// time.Time values are compared by Equal, and others by reflect.DeepEqual.
+// NULL parameters match no models as `col = NULL` does in SQL.
+func (f *IndexFinder) Match(v string) string {
+	var exprs []string
+	for _, c := range f.Columns {
+		field, param := v+"."+c.GoName(), f.ParamName(c)
+		switch {
+		case c.GoFieldType == timeType:
+			exprs = append(exprs, fmt.Sprintf("%s.Equal(%s)", field, param))
+		case c.GoFieldType == nullTimeType:
+			exprs = append(exprs, fmt.Sprintf("%s.Valid && %s.Valid && %s.V.Equal(%s.V)", param, field, field, param))
+		case strings.HasPrefix(c.GoFieldType, "null."):
+			exprs = append(exprs, fmt.Sprintf("%s.Valid && reflect.DeepEqual(%s, %s)", param, field, param))
+		case strings.HasPrefix(c.GoFieldType, "*"):
+			exprs = append(exprs, fmt.Sprintf("%s != nil && reflect.DeepEqual(%s, %s)", param, field, param))
+		default:
+			exprs = append(exprs, fmt.Sprintf("reflect.DeepEqual(%s, %s)", field, param))
+		}
+	}
+	return strings.Join(exprs, " && ")
+}
+
+// KeyLiteral is the literal of the key type of the model by parameters,
+// e.g. "UsersKey{Id: id}". It is valid only for the primary key.
+func (f *IndexFinder) KeyLiteral(model string) string {
+	var fields []string
+	for _, c := range f.Columns {
+		fields = append(fields, fmt.Sprintf("%s: %s", c.GoName(), f.ParamName(c)))
+	}
+	return fmt.Sprintf("%sKey{%s}", model, strings.Join(fields, ", "))
+}
+
+// Where is the WHERE clause with placeholders for Conds, e.g. ":? AND :?".
+func (f *IndexFinder) Where() string {
+	placeholders := make([]string, len(f.Columns))
+	for i := range f.Columns {
+		placeholders[i] = ":?"
+	}
+	return strings.Join(placeholders, " AND ")
+}
+
+// Conds are the conditions by typed columns of the model,
+// e.g. "UsersColumns.Id.Eq(id), UsersColumns.Name.Eq(name)".
+func (f *IndexFinder) Conds(model string) string {
+	var conds []string
+	for _, c := range f.Columns {
+		conds = append(conds, fmt.Sprintf("%sColumns.%s.Eq(%s)", model, c.GoName(), f.ParamName(c)))
+	}
+	return strings.Join(conds, ", ")
+}
+
+// IndexFinders returns the finder functions generated for the indexes of the table.
+// Indexes with the same columns are merged, preferring unique ones.
+// Without index metadata, e.g. by custom parsers, only the primary key and single-column unique keys
+// are derived from the keys of columns, since MUL columns may be the first ones of composite indexes.
+func (t *Table) IndexFinders() []*IndexFinder {
+	indexes := t.Indexes
+	if len(indexes) == 0 {
+		indexes = t.indexesFromKeys()
+	}
+	var ret []*IndexFinder
+	seen := map[string]*IndexFinder{}
+	model := strcase.ToCamel(t.TableName)
+	for _, idx := range indexes {
+		f := &IndexFinder{Unique: idx.Unique || idx.Primary, Primary: idx.Primary}
+		var names []string
+		for _, name := range idx.Columns {
+			c := t.Column(name)
+			if c == nil {
+				f = nil
+				break
+			}
+			f.Columns = append(f.Columns, c)
+			names = append(names, c.GoName())
+		}
+		if f == nil || len(f.Columns) == 0 {
+			continue
+		}
+		f.Name = fmt.Sprintf("Find%sBy%s", model, strings.Join(names, "And"))
+		f.Method = "FindBy" + strings.Join(names, "And")
+		if prev, ok := seen[f.Name]; ok {
+			prev.Unique = prev.Unique || f.Unique
+			prev.Primary = prev.Primary || f.Primary
+			continue
+		}
+		seen[f.Name] = f
+		ret = append(ret, f)
+	}
+	return ret
+}
+
+// PrimaryKeyFinder returns the finder by the primary key, or nil if the table has no primary key.
+func (t *Table) PrimaryKeyFinder() *IndexFinder {
+	for _, f := range t.IndexFinders() {
+		if f.Primary {
+			return f
+		}
+	}
+	return nil
+}
+
+func (t *Table) indexesFromKeys() []*Index {
+	var ret []*Index
+	if pk := t.PrimaryKeyColumns(); len(pk) > 0 {
+		idx := &Index{Name: "PRIMARY", Unique: true, Primary: true}
+		for _, c := range pk {
+			idx.Columns = append(idx.Columns, c.FieldName)
+		}
+		ret = append(ret, idx)
+	}
+	for _, c := range t.Columns {
+		// UNI is the column of a single-column unique index, and composite ones are reported as MUL
+		if c.Key.String == "UNI" {
+			ret = append(ret, &Index{Name: c.FieldName, Columns: []string{c.FieldName}, Unique: true})
+		}
+	}
+	return ret
+}
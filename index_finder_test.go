@@ -0,0 +1,108 @@
+package exql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_IndexFinders(t *testing.T) {
+	columns := []*Column{
+		{FieldName: "id", GoFieldType: "int64", Key: sql.NullString{String: "PRI", Valid: true}},
+		{FieldName: "email", GoFieldType: "string", Key: sql.NullString{String: "UNI", Valid: true}},
+		{FieldName: "type", GoFieldType: "string", Key: sql.NullString{String: "MUL", Valid: true}},
+		{FieldName: "created_at", GoFieldType: "time.Time"},
+	}
+	type finder struct {
+		name    string
+		unique  bool
+		primary bool
+		params  string
+		where   string
+		conds   string
+	}
+	finders := func(table *Table) []finder {
+		var ret []finder
+		for _, f := range table.IndexFinders() {
+			ret = append(ret, finder{f.Name, f.Unique, f.Primary, f.Params(), f.Where(), f.Conds("Users")})
+		}
+		return ret
+	}
+	t.Run("indexes", func(t *testing.T) {
+		table := &Table{
+			TableName: "users",
+			Columns:   columns,
+			Indexes: []*Index{
+				{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true},
+				{Name: "type_created_at", Columns: []string{"type", "created_at"}},
+				{Name: "email", Columns: []string{"email"}},
+				{Name: "email_unique", Columns: []string{"email"}, Unique: true},
+				{Name: "unknown", Columns: []string{"unknown"}},
+			},
+		}
+		assert.Equal(t, []finder{
+			{"FindUsersById", true, true, "id int64", ":?", "UsersColumns.Id.Eq(id)"},
+			{
+				"FindUsersByTypeAndCreatedAt", false, false,
+				"typeValue string, createdAt time.Time", ":? AND :?",
+				"UsersColumns.Type.Eq(typeValue), UsersColumns.CreatedAt.Eq(createdAt)",
+			},
+			{"FindUsersByEmail", true, false, "email string", ":?", "UsersColumns.Email.Eq(email)"},
+		}, finders(table))
+	})
+	t.Run("keys", func(t *testing.T) {
+		table := &Table{TableName: "users", Columns: columns}
+		assert.Equal(t, []finder{
+			{"FindUsersById", true, true, "id int64", ":?", "UsersColumns.Id.Eq(id)"},
+			{"FindUsersByEmail", true, false, "email string", ":?", "UsersColumns.Email.Eq(email)"},
+		}, finders(table))
+	})
+	t.Run("reserved names", func(t *testing.T) {
+		f := &IndexFinder{}
+		for _, name := range []string{"ctx", "finder", "dest", "err", "query", "func", "range"} {
+			assert.Equal(t, name+"Value", f.ParamName(&Column{FieldName: name}))
+		}
+	})
+}
+
+func TestGeneratedIndexFinders(t *testing.T) {
+	t.Run("unique", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		mock.ExpectQuery("SELECT \\* FROM `user_login_histories` WHERE `user_login_histories`.`id` = \\? AND `user_login_histories`.`created_at` = \\?").
+			WithArgs(int64(1), createdAt).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "created_at"}).AddRow(1, 2, createdAt))
+		history, err := model.FindUserLoginHistoriesByIdAndCreatedAt(context.Background(), NewFinder(db), 1, createdAt)
+		assert.NoError(t, err)
+		assert.Equal(t, &model.UserLoginHistories{Id: 1, UserId: 2, CreatedAt: createdAt}, history)
+	})
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\?").
+			WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}))
+		user, err := model.FindUsersById(context.Background(), NewFinder(db), 1)
+		assert.ErrorIs(t, err, ErrRecordNotFound{})
+		assert.Nil(t, user)
+	})
+	t.Run("non-unique", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery("SELECT \\* FROM `group_users` WHERE `group_users`.`user_id` = \\?").
+			WithArgs(int64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "group_id"}).AddRow(1, 2, 3).AddRow(4, 2, 5))
+		groupUsers, err := model.FindGroupUsersByUserId(context.Background(), NewFinder(db), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.GroupUsers{{Id: 1, UserId: 2, GroupId: 3}, {Id: 4, UserId: 2, GroupId: 5}}, groupUsers)
+	})
+}
@@ -0,0 +1,158 @@
+package exql
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+type informationSchemaParser struct{}
+
+// NewInformationSchemaParser returns the parser reading tables from information_schema
+// of the current database. In addition to columns, comments and indexes that ParseTable of NewParser reads,
+// it fills foreign keys of the table.
+func NewInformationSchemaParser() Parser {
+	return &informationSchemaParser{}
+}
+
+func (p *informationSchemaParser) ParseTable(db *sql.DB, table string) (*Table, error) {
+	var comment, tableType string
+	if err := db.QueryRow(
+		"select table_comment, table_type from information_schema.tables where table_schema = database() and table_name = ?",
+		table,
+	).Scan(&comment, &tableType); err == sql.ErrNoRows {
+		return nil, fmt.Errorf("table not found: %s", table)
+	} else if err != nil {
+		return nil, err
+	}
+	cols, err := p.parseColumns(db, table)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := p.parseIndexes(db, table)
+	if err != nil {
+		return nil, err
+	}
+	foreignKeys, err := p.parseForeignKeys(db, table)
+	if err != nil {
+		return nil, err
+	}
+	if tableType == "VIEW" {
+		// Views are commented as "VIEW" by MySQL
+		comment = ""
+	}
+	return &Table{
+		TableName:   table,
+		View:        tableType == "VIEW",
+		Comment:     comment,
+		Columns:     cols,
+		Indexes:     indexes,
+		ForeignKeys: foreignKeys,
+	}, nil
+}
+
+func (p *informationSchemaParser) parseColumns(db *sql.DB, table string) ([]*Column, error) {
+	rows, err := db.Query(
+		"select column_name, column_type, is_nullable, column_key, column_default, extra, "+
+			"column_comment, character_set_name, collation_name "+
+			"from information_schema.columns where table_schema = database() and table_name = ? "+
+			"order by ordinal_position",
+		table,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var cols []*Column
+	for rows.Next() {
+		col := &Column{FieldIndex: len(cols)}
+		var nullable string
+		var charset, collation sql.NullString
+		if err := rows.Scan(
+			&col.FieldName, &col.FieldType, &nullable, &col.Key, &col.DefaultValue, &col.Extra,
+			&col.Comment, &charset, &collation,
+		); err != nil {
+			return nil, err
+		}
+		col.Nullable = nullable == "YES"
+		col.CharacterSet = charset.String
+		col.Collation = collation.String
+		col.GoFieldType, err = ParseType(col.FieldType, col.Nullable)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, col)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return cols, nil
+}
+
+func (p *informationSchemaParser) parseIndexes(db *sql.DB, table string) ([]*Index, error) {
+	rows, err := db.Query(
+		"select index_name, non_unique, column_name from information_schema.statistics "+
+			"where table_schema = database() and table_name = ? "+
+			"order by index_name = 'PRIMARY' desc, index_name, seq_in_index",
+		table,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var indexes []*Index
+	for rows.Next() {
+		var name string
+		var nonUnique bool
+		var col sql.NullString
+		if err := rows.Scan(&name, &nonUnique, &col); err != nil {
+			return nil, err
+		}
+		if len(indexes) == 0 || indexes[len(indexes)-1].Name != name {
+			indexes = append(indexes, &Index{
+				Name:    name,
+				Unique:  !nonUnique,
+				Primary: name == "PRIMARY",
+			})
+		}
+		// Functional key parts have no column
+		if col.Valid {
+			idx := indexes[len(indexes)-1]
+			idx.Columns = append(idx.Columns, col.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+func (p *informationSchemaParser) parseForeignKeys(db *sql.DB, table string) ([]*ForeignKey, error) {
+	rows, err := db.Query(
+		"select constraint_name, column_name, referenced_table_name, referenced_column_name "+
+			"from information_schema.key_column_usage "+
+			"where table_schema = database() and table_name = ? and referenced_table_name is not null "+
+			"order by constraint_name, ordinal_position",
+		table,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var foreignKeys []*ForeignKey
+	for rows.Next() {
+		var name, col, refTable, refCol string
+		if err := rows.Scan(&name, &col, &refTable, &refCol); err != nil {
+			return nil, err
+		}
+		if len(foreignKeys) == 0 || foreignKeys[len(foreignKeys)-1].Name != name {
+			foreignKeys = append(foreignKeys, &ForeignKey{Name: name, ReferencedTable: refTable})
+		}
+		fk := foreignKeys[len(foreignKeys)-1]
+		fk.Columns = append(fk.Columns, col)
+		fk.ReferencedColumns = append(fk.ReferencedColumns, refCol)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return foreignKeys, nil
+}
@@ -0,0 +1,161 @@
+package exql
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformationSchemaParser_ParseTable(t *testing.T) {
+	p := NewInformationSchemaParser()
+	str := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	columns := []string{
+		"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra",
+		"column_comment", "character_set_name", "collation_name",
+	}
+	t.Run("basic", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("from information_schema.tables").WithArgs("group_users").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("members of groups", "BASE TABLE"))
+		mock.ExpectQuery("from information_schema.columns").WithArgs("group_users").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("id", "int", "NO", "PRI", nil, "auto_increment", "", nil, nil).
+				AddRow("user_id", "int", "NO", "MUL", nil, "", "users.id", nil, nil).
+				AddRow("note", "varchar(255)", "YES", "", "none", "", "", "utf8mb4", "utf8mb4_bin"))
+		mock.ExpectQuery("from information_schema.statistics").WithArgs("group_users").
+			WillReturnRows(sqlmock.NewRows([]string{"index_name", "non_unique", "column_name"}).
+				AddRow("PRIMARY", 0, "id").
+				AddRow("group_users_note", 0, "note").
+				AddRow("group_users_note", 0, "user_id").
+				AddRow("group_users_user_id", 1, "user_id").
+				AddRow("group_users_user_id", 1, nil))
+		mock.ExpectQuery("from information_schema.key_column_usage").WithArgs("group_users").
+			WillReturnRows(sqlmock.NewRows([]string{"constraint_name", "column_name", "referenced_table_name", "referenced_column_name"}).
+				AddRow("group_users_ibfk_1", "user_id", "users", "id").
+				AddRow("group_users_ibfk_2", "id", "members", "group_user_id").
+				AddRow("group_users_ibfk_2", "user_id", "members", "user_id"))
+		table, err := p.ParseTable(mockDb, "group_users")
+		assert.NoError(t, err)
+		assert.Equal(t, &Table{
+			TableName: "group_users",
+			Comment:   "members of groups",
+			Columns: []*Column{
+				{FieldName: "id", FieldType: "int", FieldIndex: 0, GoFieldType: "int64", Key: str("PRI"), Extra: str("auto_increment")},
+				{FieldName: "user_id", FieldType: "int", FieldIndex: 1, GoFieldType: "int64", Key: str("MUL"), Extra: str(""), Comment: "users.id"},
+				{
+					FieldName: "note", FieldType: "varchar(255)", FieldIndex: 2, GoFieldType: "null.String", Nullable: true,
+					DefaultValue: str("none"), Key: str(""), Extra: str(""), CharacterSet: "utf8mb4", Collation: "utf8mb4_bin",
+				},
+			},
+			Indexes: []*Index{
+				{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true},
+				{Name: "group_users_note", Columns: []string{"note", "user_id"}, Unique: true},
+				{Name: "group_users_user_id", Columns: []string{"user_id"}},
+			},
+			ForeignKeys: []*ForeignKey{
+				{Name: "group_users_ibfk_1", Columns: []string{"user_id"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+				{Name: "group_users_ibfk_2", Columns: []string{"id", "user_id"}, ReferencedTable: "members", ReferencedColumns: []string{"group_user_id", "user_id"}},
+			},
+		}, table)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("view", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("from information_schema.tables").WithArgs("active_users").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("VIEW", "VIEW"))
+		mock.ExpectQuery("from information_schema.columns").WithArgs("active_users").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("id", "int", "NO", "", "0", "", "", nil, nil))
+		mock.ExpectQuery("from information_schema.statistics").WithArgs("active_users").
+			WillReturnRows(sqlmock.NewRows([]string{"index_name", "non_unique", "column_name"}))
+		mock.ExpectQuery("from information_schema.key_column_usage").WithArgs("active_users").
+			WillReturnRows(sqlmock.NewRows([]string{"constraint_name", "column_name", "referenced_table_name", "referenced_column_name"}))
+		table, err := p.ParseTable(mockDb, "active_users")
+		assert.NoError(t, err)
+		assert.True(t, table.View)
+		assert.Equal(t, "", table.Comment)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("should return error if table not found", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("from information_schema.tables").WithArgs("users").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}))
+		_, err = p.ParseTable(mockDb, "users")
+		assert.EqualError(t, err, "table not found: users")
+	})
+	t.Run("should return error if column type is unknown", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery("from information_schema.tables").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("", "BASE TABLE"))
+		mock.ExpectQuery("from information_schema.columns").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("id", "uuid", "NO", "", nil, "", "", nil, nil))
+		_, err = p.ParseTable(mockDb, "users")
+		assert.EqualError(t, err, "unknown type: uuid")
+	})
+	t.Run("should return error if query fails", func(t *testing.T) {
+		for i, query := range []string{
+			"information_schema.tables",
+			"information_schema.columns",
+			"information_schema.statistics",
+			"information_schema.key_column_usage",
+		} {
+			t.Run(query, func(t *testing.T) {
+				mockDb, mock, err := sqlmock.New()
+				assert.NoError(t, err)
+				defer mockDb.Close()
+				expectations := []func() *sqlmock.ExpectedQuery{
+					func() *sqlmock.ExpectedQuery {
+						return mock.ExpectQuery("information_schema.tables").
+							WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("", "BASE TABLE"))
+					},
+					func() *sqlmock.ExpectedQuery {
+						return mock.ExpectQuery("information_schema.columns").WillReturnRows(sqlmock.NewRows(columns))
+					},
+					func() *sqlmock.ExpectedQuery {
+						return mock.ExpectQuery("information_schema.statistics").
+							WillReturnRows(sqlmock.NewRows([]string{"index_name", "non_unique", "column_name"}))
+					},
+				}
+				for _, e := range expectations[:i] {
+					e()
+				}
+				mock.ExpectQuery(query).WillReturnError(fmt.Errorf("err"))
+				_, err = p.ParseTable(mockDb, "users")
+				assert.EqualError(t, err, "err")
+			})
+		}
+	})
+}
+
+func TestInformationSchemaParser_ParseTable_MySQL(t *testing.T) {
+	db := testSqlDB()
+	defer db.Close()
+	table, err := NewInformationSchemaParser().ParseTable(db, "group_users")
+	assert.NoError(t, err)
+	// Columns and comments must be the same as SHOW FULL COLUMNS
+	showColumns, err := NewParser().ParseTable(db, "group_users")
+	assert.NoError(t, err)
+	assert.Equal(t, showColumns.Columns, table.Columns)
+	assert.Equal(t, showColumns.Comment, table.Comment)
+	assert.Equal(t, []*Index{
+		{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true},
+		{Name: "group_users_group_id", Columns: []string{"group_id"}},
+		{Name: "group_users_user_id", Columns: []string{"user_id"}},
+	}, table.Indexes)
+	assert.Equal(t, []*ForeignKey{
+		{Name: "group_users_ibfk_1", Columns: []string{"user_id"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+		{Name: "group_users_ibfk_2", Columns: []string{"group_id"}, ReferencedTable: "user_groups", ReferencedColumns: []string{"id"}},
+	}, table.ForeignKeys)
+}
@@ -5,6 +5,9 @@ import (
 )
 
 type Executor = iface.Executor
+type Finder = iface.Finder
+type EmptyFinder = iface.EmptyFinder
+type Saver = iface.Saver
 type Model = iface.Model
 type ModelUpdate = iface.ModelUpdate
 type SqlRows = iface.SqlRows
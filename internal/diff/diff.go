@@ -0,0 +1,142 @@
+// Package diff provides a minimal line-based unified diff for generated sources.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines around each change.
+const contextLines = 3
+
+type opKind byte
+
+const (
+	opEqual  opKind = ' '
+	opDelete opKind = '-'
+	opInsert opKind = '+'
+)
+
+type op struct {
+	kind opKind
+	line string
+	// 0-based line indexes in old and new
+	oldIndex, newIndex int
+}
+
+// Unified returns the unified diff from oldText to newText, or empty string if they are identical.
+// Empty name is shown as /dev/null, as it is for added or removed files.
+func Unified(oldName, newName, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+	oldLines := splitLines(oldText)
+	newLines := splitLines(newText)
+	ops := diffLines(oldLines, newLines)
+	if oldName == "" {
+		oldName = "/dev/null"
+	}
+	if newName == "" {
+		newName = "/dev/null"
+	}
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "--- %s\n+++ %s\n", oldName, newName)
+	for i := 0; i < len(ops); {
+		// Find the next change
+		for i < len(ops) && ops[i].kind == opEqual {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+		start := max(i-contextLines, 0)
+		end := i
+		// Extend the hunk while changes are close enough to be merged
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind != opEqual {
+				end = j + 1
+			} else if j-end >= contextLines*2 {
+				break
+			}
+		}
+		end = min(end+contextLines, len(ops))
+		writeHunk(b, ops[start:end])
+		i = end
+	}
+	return b.String()
+}
+
+func writeHunk(b *strings.Builder, ops []op) {
+	oldStart, newStart := ops[0].oldIndex, ops[0].newIndex
+	var oldCount, newCount int
+	for _, o := range ops {
+		if o.kind != opInsert {
+			oldCount++
+		}
+		if o.kind != opDelete {
+			newCount++
+		}
+	}
+	fmt.Fprintf(b, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+	for _, o := range ops {
+		b.WriteByte(byte(o.kind))
+		b.WriteString(o.line)
+		b.WriteByte('\n')
+	}
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		// The line before the empty range, as GNU diff does
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes the edit script by the longest common subsequence.
+// Model files are small enough for the quadratic table.
+func diffLines(a, b []string) []op {
+	n, m := len(a), len(b)
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var ops []op
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && a[i] == b[j]:
+			ops = append(ops, op{kind: opEqual, line: a[i], oldIndex: i, newIndex: j})
+			i++
+			j++
+		case i < n && (j == m || lcs[i+1][j] >= lcs[i][j+1]):
+			// Deletions come first, as diff(1) does
+			ops = append(ops, op{kind: opDelete, line: a[i], oldIndex: i, newIndex: j})
+			i++
+		default:
+			ops = append(ops, op{kind: opInsert, line: b[j], oldIndex: i, newIndex: j})
+			j++
+		}
+	}
+	return ops
+}
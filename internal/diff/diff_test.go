@@ -0,0 +1,57 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnified(t *testing.T) {
+	t.Run("identical", func(t *testing.T) {
+		assert.Equal(t, "", Unified("a", "b", "x\ny\n", "x\ny\n"))
+	})
+	t.Run("changed", func(t *testing.T) {
+		oldText := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+		newText := "1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n11\n"
+		assert.Equal(t, `--- a.go
++++ b.go
+@@ -2,9 +2,10 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+ 9
+ 10
++11
+`, Unified("a.go", "b.go", oldText, newText))
+	})
+	t.Run("separate hunks", func(t *testing.T) {
+		oldText := "a\n1\n2\n3\n4\n5\n6\n7\n8\nb\n"
+		newText := "A\n1\n2\n3\n4\n5\n6\n7\n8\nB\n"
+		assert.Equal(t, `--- x
++++ y
+@@ -1,4 +1,4 @@
+-a
++A
+ 1
+ 2
+ 3
+@@ -7,4 +7,4 @@
+ 6
+ 7
+ 8
+-b
++B
+`, Unified("x", "y", oldText, newText))
+	})
+	t.Run("added", func(t *testing.T) {
+		assert.Equal(t, "--- /dev/null\n+++ b.go\n@@ -0,0 +1,2 @@\n+x\n+y\n", Unified("", "b.go", "", "x\ny\n"))
+	})
+	t.Run("removed", func(t *testing.T) {
+		assert.Equal(t, "--- a.go\n+++ /dev/null\n@@ -1 +0,0 @@\n-x\n", Unified("a.go", "", "x\n", ""))
+	})
+}
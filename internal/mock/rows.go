@@ -26,8 +26,21 @@ func (r *Rows) Next() bool {
 	return true
 }
 
+// Scan assigns values of the current row into *any destinations, leaving others untouched.
 func (r *Rows) Scan(dest ...any) error {
-	return r.ScanErr
+	if r.ScanErr != nil {
+		return r.ScanErr
+	}
+	if r.Idx == 0 || r.Idx > len(r.Values) {
+		return nil
+	}
+	row := r.Values[r.Idx-1]
+	for i, d := range dest {
+		if p, ok := d.(*any); ok && i < len(row) {
+			*p = row[i]
+		}
+	}
+	return nil
 }
 
 func (r *Rows) Err() error {
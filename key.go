@@ -0,0 +1,78 @@
+package exql
+
+import (
+	"fmt"
+	"slices"
+
+	q "github.com/loilo-inc/exql/v3/query"
+)
+
+var errNoPrimaryKey = fmt.Errorf("model has no primary key")
+
+// PrimaryKeyCond returns the condition matching the record of the model by its primary key,
+// like "(`histories`.`id` = ? AND `histories`.`created_at` = ?)" for composite keys.
+// Errors are returned when the condition is built into the query.
+//
+//	_, err := db.UpdateModel(update, exql.PrimaryKeyCond(history))
+func PrimaryKeyCond(modelPtr Model) q.Condition {
+	key, vals, err := primaryKeyOf(modelPtr)
+	if err != nil {
+		return q.CondFrom(q.Err(err))
+	}
+	return key.Eq(vals...)
+}
+
+// PrimaryKeysIn returns the condition matching records of the models by their primary keys,
+// like "(`histories`.`id`,`histories`.`created_at`) IN ((?,?),(?,?))" for composite keys.
+// Errors are returned when the condition is built into the query.
+func PrimaryKeysIn[T Model](modelPtrs ...T) q.Condition {
+	if len(modelPtrs) == 0 {
+		return q.CondFrom(q.Err(fmt.Errorf("empty list")))
+	}
+	var key q.Key
+	var tuples [][]any
+	for _, m := range modelPtrs {
+		k, vals, err := primaryKeyOf(m)
+		if err != nil {
+			return q.CondFrom(q.Err(err))
+		}
+		key = k
+		tuples = append(tuples, vals)
+	}
+	return key.In(tuples...)
+}
+
+// primaryKeyOf returns the primary key of the model in the key order of its metadata, and values of it.
+func primaryKeyOf(modelPtr Model) (q.Key, []any, error) {
+	table, err := TableMetadataOf(modelPtr)
+	if err != nil {
+		return q.Key{}, nil, err
+	}
+	if len(table.PrimaryKey) == 0 {
+		return q.Key{}, nil, errNoPrimaryKey
+	}
+	dest, err := resolveDestination(modelPtr)
+	if err != nil {
+		return q.Key{}, nil, err
+	}
+	fields, err := parsePrimaryFields(dest.Type())
+	if err != nil {
+		return q.Key{}, nil, err
+	}
+	vals := make([]any, len(table.PrimaryKey))
+	found := 0
+	for _, i := range fields {
+		tags, err := ParseTags(dest.Type().Field(i).Tag.Get("exql"))
+		if err != nil {
+			return q.Key{}, nil, err
+		}
+		if j := slices.Index(table.PrimaryKey, tags["column"]); j >= 0 {
+			vals[j] = dest.Field(i).Interface()
+			found++
+		}
+	}
+	if found != len(vals) {
+		return q.Key{}, nil, fmt.Errorf("primary key columns are not tagged as primary in fields")
+	}
+	return q.NewKey(table.Name, table.PrimaryKey...), vals, nil
+}
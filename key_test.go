@@ -0,0 +1,120 @@
+package exql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type keyTestModel struct {
+	CreatedAt int64  `exql:"column:created_at;primary"`
+	Id        int64  `exql:"column:id;primary"`
+	Name      string `exql:"column:name"`
+}
+
+func (keyTestModel) TableName() string {
+	return "histories"
+}
+
+type keyTestModelNoKey struct {
+	Name string `exql:"column:name"`
+}
+
+func (keyTestModelNoKey) TableName() string {
+	return "logs"
+}
+
+func TestPrimaryKeyCond(t *testing.T) {
+	t.Run("tagged", func(t *testing.T) {
+		stmt, args, err := PrimaryKeyCond(&keyTestModel{Id: 1, CreatedAt: 2}).Query()
+		assert.NoError(t, err)
+		assert.Equal(t, "(`histories`.`created_at` = ? AND `histories`.`id` = ?)", stmt)
+		assert.Equal(t, []any{int64(2), int64(1)}, args)
+	})
+	t.Run("generated in the key order", func(t *testing.T) {
+		now := time.Now()
+		stmt, args, err := PrimaryKeyCond(&model.UserLoginHistories{Id: 1, UserId: 2, CreatedAt: now}).Query()
+		assert.NoError(t, err)
+		assert.Equal(t, "(`user_login_histories`.`id` = ? AND `user_login_histories`.`created_at` = ?)", stmt)
+		assert.Equal(t, []any{int64(1), now}, args)
+	})
+	t.Run("should error without primary key", func(t *testing.T) {
+		_, _, err := PrimaryKeyCond(&keyTestModelNoKey{}).Query()
+		assert.ErrorIs(t, err, errNoPrimaryKey)
+	})
+	t.Run("should error if model is nil", func(t *testing.T) {
+		_, _, err := PrimaryKeyCond(nil).Query()
+		assert.ErrorIs(t, err, errModelNil)
+	})
+}
+
+func TestPrimaryKeysIn(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		stmt, args, err := PrimaryKeysIn(&keyTestModel{Id: 1, CreatedAt: 2}, &keyTestModel{Id: 3, CreatedAt: 4}).Query()
+		assert.NoError(t, err)
+		assert.Equal(t, "(`histories`.`created_at`,`histories`.`id`) IN ((?,?),(?,?))", stmt)
+		assert.Equal(t, []any{int64(2), int64(1), int64(4), int64(3)}, args)
+	})
+	t.Run("should error if empty", func(t *testing.T) {
+		_, _, err := PrimaryKeysIn[*keyTestModel]().Query()
+		assert.EqualError(t, err, "empty list")
+	})
+	t.Run("should error without primary key", func(t *testing.T) {
+		_, _, err := PrimaryKeysIn(&keyTestModelNoKey{}).Query()
+		assert.ErrorIs(t, err, errNoPrimaryKey)
+	})
+}
+
+func TestGeneratedPrimaryKey(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := &model.UserLoginHistories{Id: 1, UserId: 2, CreatedAt: now}
+	t.Run("key", func(t *testing.T) {
+		key := history.PrimaryKey()
+		assert.Equal(t, model.UserLoginHistoriesKey{Id: 1, CreatedAt: now}, key)
+		stmt, args, err := key.Cond().Query()
+		assert.NoError(t, err)
+		assert.Equal(t, "(`user_login_histories`.`id` = ? AND `user_login_histories`.`created_at` = ?)", stmt)
+		assert.Equal(t, []any{int64(1), now}, args)
+	})
+	t.Run("update and delete by key", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectExec("UPDATE `user_login_histories` SET `user_id` = \\? WHERE \\(`user_login_histories`.`id` = \\? AND `user_login_histories`.`created_at` = \\?\\)").
+			WithArgs(int64(3), int64(1), now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM `user_login_histories` WHERE \\(`user_login_histories`.`id` = \\? AND `user_login_histories`.`created_at` = \\?\\)").
+			WithArgs(int64(1), now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s := NewSaver(db)
+		_, err = s.UpdateModel(model.NewUpdateUserLoginHistories().SetUserId(3), history.PrimaryKey().Cond())
+		assert.NoError(t, err)
+		_, err = s.Delete(model.UserLoginHistoriesTableName, PrimaryKeyCond(history))
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("find by keys", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery("SELECT \\* FROM `user_login_histories` WHERE \\(`user_login_histories`.`id`,`user_login_histories`.`created_at`\\) IN \\(\\(\\?,\\?\\),\\(\\?,\\?\\)\\)").
+			WithArgs(int64(1), now, int64(2), now).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "created_at"}).AddRow(1, 2, now))
+		list, err := model.FindUserLoginHistoriesByKeys(context.Background(), NewFinder(db),
+			model.UserLoginHistoriesKey{Id: 1, CreatedAt: now},
+			model.UserLoginHistoriesKey{Id: 2, CreatedAt: now},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.UserLoginHistories{history}, list)
+
+		list, err = model.FindUserLoginHistoriesByKeys(context.Background(), NewFinder(db))
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+		assert.NotNil(t, list)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
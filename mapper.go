@@ -1,16 +1,18 @@
 package exql
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/loilo-inc/exql/v3/iface"
 )
 
 // Error returned when record not found
-type ErrRecordNotFound struct{}
-
-func (e ErrRecordNotFound) Error() string {
-	return "record not found"
-}
+type ErrRecordNotFound = iface.ErrRecordNotFound
 
 // ColumnSplitter is a function type for providing head column name for each destination struct in SerialMapper.
 type ColumnSplitter func(i int) string
@@ -94,6 +96,28 @@ func MapRow(
 func MapRows(
 	rows SqlRows,
 	ptrOfSliceOfModelPtr any,
+) error {
+	return mapRows(rows, ptrOfSliceOfModelPtr, false)
+}
+
+// MapRowsOrEmpty is same as MapRows except that it doesn't return ErrRecordNotFound.
+// If no rows are found, the destination is set to an empty, non-nil slice.
+//
+// Example:
+//
+//	var users []*Users
+//	err := exql.MapRowsOrEmpty(rows, &users) // users = []*Users{}
+func MapRowsOrEmpty(
+	rows SqlRows,
+	ptrOfSliceOfModelPtr any,
+) error {
+	return mapRows(rows, ptrOfSliceOfModelPtr, true)
+}
+
+func mapRows(
+	rows SqlRows,
+	ptrOfSliceOfModelPtr any,
+	allowEmpty bool,
 ) error {
 	defer rows.Close()
 
@@ -125,11 +149,183 @@ func MapRows(
 		return err
 	}
 	if cnt == 0 {
-		return ErrRecordNotFound{}
+		if !allowEmpty {
+			return ErrRecordNotFound{}
+		}
+		if destValue.Elem().IsNil() {
+			// *dest = []*Model{}
+			destValue.Elem().Set(reflect.MakeSlice(destValue.Elem().Type(), 0, 0))
+		}
+	}
+	return nil
+}
+
+var errScalarColumns = fmt.Errorf("scalar destination requires exactly one column")
+
+// MapScalar reads the single column of the first row into a value of type T.
+// It closes rows after mapping regardless error occurred.
+//
+// Example:
+//
+//	count, err := exql.MapScalar[int64](rows)
+func MapScalar[T any](row SqlRows) (T, error) {
+	defer row.Close()
+
+	var dest T
+	scanned := false
+	if row.Next() {
+		if err := assertScalarColumns(row); err != nil {
+			return dest, err
+		}
+		if err := row.Scan(&dest); err != nil {
+			return dest, err
+		}
+		scanned = true
+	}
+	if err := row.Err(); err != nil {
+		return dest, err
+	} else if !scanned {
+		return dest, ErrRecordNotFound{}
+	}
+	return dest, nil
+}
+
+// MapScalars reads the single column of all rows into a slice of T.
+// It closes rows after mapping regardless error occurred.
+//
+// Example:
+//
+//	ids, err := exql.MapScalars[int64](rows)
+func MapScalars[T any](rows SqlRows) ([]T, error) {
+	defer rows.Close()
+
+	if err := assertScalarColumns(rows); err != nil {
+		return nil, err
+	}
+	var dest []T
+	for rows.Next() {
+		var v T
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		dest = append(dest, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(dest) == 0 {
+		return nil, ErrRecordNotFound{}
+	}
+	return dest, nil
+}
+
+// MapMaps reads all data from rows and maps each row into a map keyed by column name.
+// Byte slices returned by the driver are converted into int64, uint64, float64,
+// string or json.RawMessage according to the column's database type if rows provides
+// ColumnTypes() like *sql.Rows. Binary columns are kept as []byte.
+// It closes rows after mapping regardless error occurred.
+//
+// Example:
+//
+//	users, err := exql.MapMaps(rows)
+//	name := users[0]["name"].(string)
+func MapMaps(rows SqlRows) ([]map[string]any, error) {
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	typeNames, err := columnTypeNames(rows)
+	if err != nil {
+		return nil, err
+	}
+	var dest []map[string]any
+	for rows.Next() {
+		values := make([]any, len(cols))
+		receivers := make([]any, len(cols))
+		for i := range values {
+			receivers[i] = &values[i]
+		}
+		if err := rows.Scan(receivers...); err != nil {
+			return nil, err
+		}
+		m := make(map[string]any, len(cols))
+		for i, col := range cols {
+			var typeName string
+			if typeNames != nil {
+				typeName = typeNames[i]
+			}
+			if m[col], err = convertDriverValue(values[i], typeName); err != nil {
+				return nil, err
+			}
+		}
+		dest = append(dest, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(dest) == 0 {
+		return nil, ErrRecordNotFound{}
+	}
+	return dest, nil
+}
+
+func assertScalarColumns(rows SqlRows) error {
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	} else if len(cols) != 1 {
+		return fmt.Errorf("%w: got %d", errScalarColumns, len(cols))
 	}
 	return nil
 }
 
+// columnTyper is implemented by *sql.Rows.
+type columnTyper interface {
+	ColumnTypes() ([]*sql.ColumnType, error)
+}
+
+func columnTypeNames(rows SqlRows) ([]string, error) {
+	typer, ok := rows.(columnTyper)
+	if !ok {
+		return nil, nil
+	}
+	types, err := typer.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = strings.ToUpper(t.DatabaseTypeName())
+	}
+	return names, nil
+}
+
+// convertDriverValue converts a byte slice returned by the driver into the Go value
+// corresponding to the database type. Unknown types are returned as is.
+func convertDriverValue(v any, typeName string) (any, error) {
+	b, ok := v.([]byte)
+	if !ok || typeName == "" {
+		return v, nil
+	}
+	switch {
+	case strings.HasPrefix(typeName, "UNSIGNED ") && strings.HasSuffix(typeName, "INT"):
+		return strconv.ParseUint(string(b), 10, 64)
+	case strings.HasSuffix(typeName, "INT"), typeName == "YEAR":
+		return strconv.ParseInt(string(b), 10, 64)
+	case typeName == "FLOAT", typeName == "DOUBLE":
+		return strconv.ParseFloat(string(b), 64)
+	case typeName == "JSON":
+		return json.RawMessage(b), nil
+	case strings.HasSuffix(typeName, "BLOB"), strings.HasSuffix(typeName, "BINARY"),
+		typeName == "BIT", typeName == "GEOMETRY":
+		return b, nil
+	}
+	// DECIMAL is kept as string in order to preserve its precision.
+	return string(b), nil
+}
+
 func (m *serialMapper) Map(
 	rows SqlRows,
 	dest ...any,
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/loilo-inc/exql/v3/decimal"
 	"github.com/loilo-inc/exql/v3/internal/mock"
 	"github.com/loilo-inc/exql/v3/mocks/mock_iface"
 	"github.com/loilo-inc/exql/v3/model"
@@ -105,6 +106,23 @@ func setupFields(t *testing.T, db DB) *model.Fields {
 		LongblobNullField:              null.New(longblob),
 		JsonField:                      rawJson,
 		JsonNullField:                  null.New(rawJson),
+		BoolField:                      true,
+		BoolNullField:                  null.New(false),
+		DecimalField:                   decimal.MustNew("12345678.90"),
+		DecimalNullField:               null.New(decimal.MustNew("-0.01")),
+		EnumField:                      model.FieldsEnumFieldEnumA,
+		EnumNullField:                  null.New(model.FieldsEnumNullFieldEnumB),
+		SetField:                       model.FieldsSetFieldSet{model.FieldsSetFieldSetA, model.FieldsSetFieldSetB},
+		SetNullField:                   null.New(model.FieldsSetNullFieldSet{model.FieldsSetNullFieldSetB}),
+		BitField:                       []byte{0x05},
+		BitNullField:                   null.New([]byte{0xff}),
+		YearField:                      2024,
+		YearNullField:                  null.New[int64](1999),
+		BinaryField:                    []byte{1, 2, 3, 4},
+		BinaryNullField:                null.New([]byte{5, 6, 7, 8}),
+		VarbinaryField:                 []byte("varbinary"),
+		VarbinaryNullField:             null.New([]byte("varbinary")),
+		GeometryNullField:              null.Bytes{},
 	}
 	_, err := db.Insert(&field)
 	assert.False(t, field.Id == 0)
@@ -169,6 +187,23 @@ func assertFields(t *testing.T, dest *model.Fields, field *model.Fields) {
 	assert.ElementsMatch(t, dest.LongblobNullField.V, field.LongblobNullField.V)
 	assert.JSONEq(t, string(dest.JsonField), string(field.JsonField))
 	assert.JSONEq(t, string(dest.JsonNullField.V), string(field.JsonNullField.V))
+	assert.Equal(t, dest.BoolField, field.BoolField)
+	assert.Equal(t, dest.BoolNullField.V, field.BoolNullField.V)
+	assert.Equal(t, dest.DecimalField, field.DecimalField)
+	assert.Equal(t, dest.DecimalNullField.V, field.DecimalNullField.V)
+	assert.Equal(t, dest.EnumField, field.EnumField)
+	assert.Equal(t, dest.EnumNullField.V, field.EnumNullField.V)
+	assert.Equal(t, dest.SetField, field.SetField)
+	assert.Equal(t, dest.SetNullField.V, field.SetNullField.V)
+	assert.Equal(t, dest.BitField, field.BitField)
+	assert.Equal(t, dest.BitNullField.V, field.BitNullField.V)
+	assert.Equal(t, dest.YearField, field.YearField)
+	assert.Equal(t, dest.YearNullField.V, field.YearNullField.V)
+	assert.Equal(t, dest.BinaryField, field.BinaryField)
+	assert.Equal(t, dest.BinaryNullField.V, field.BinaryNullField.V)
+	assert.Equal(t, dest.VarbinaryField, field.VarbinaryField)
+	assert.Equal(t, dest.VarbinaryNullField.V, field.VarbinaryNullField.V)
+	assert.Equal(t, dest.GeometryNullField.Valid, field.GeometryNullField.Valid)
 }
 func TestMapRows(t *testing.T) {
 	db := testDb()
@@ -278,6 +313,36 @@ func TestMapRows(t *testing.T) {
 	})
 }
 
+func TestMapRowsOrEmpty(t *testing.T) {
+	t.Run("should set empty slice if rows is empty", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}}
+		var dest []*model.Users
+		err := MapRowsOrEmpty(rows, &dest)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.Users{}, dest)
+	})
+	t.Run("should keep destination if it is not nil", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}}
+		dest := make([]*model.Users, 0, 10)
+		err := MapRowsOrEmpty(rows, &dest)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, cap(dest))
+	})
+	t.Run("should map rows", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, Values: [][]any{{1}, {2}}}
+		var dest []*model.Users
+		err := MapRowsOrEmpty(rows, &dest)
+		assert.NoError(t, err)
+		assert.Len(t, dest, 2)
+	})
+	t.Run("should return error if rows.Err() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, ErrErr: fmt.Errorf("error")}
+		var dest []*model.Users
+		err := MapRowsOrEmpty(rows, &dest)
+		assert.EqualError(t, err, "error")
+	})
+}
+
 func TestMapRow(t *testing.T) {
 	db := testDb()
 	t.Run("users", func(t *testing.T) {
@@ -405,6 +470,157 @@ func TestMapRow(t *testing.T) {
 	})
 }
 
+func TestMapScalars(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery(`SELECT id FROM users`).WillReturnRows(
+			sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		rows, err := mockDb.Query(`SELECT id FROM users`)
+		assert.NoError(t, err)
+		ids, err := MapScalars[int64](rows)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, ids)
+	})
+	t.Run("should return exql.ErrRecordNotFound if rows is empty", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}}
+		ids, err := MapScalars[int64](rows)
+		assert.Nil(t, ids)
+		assert.ErrorIs(t, err, ErrRecordNotFound{})
+	})
+	t.Run("should return error if columns are not single", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id", "name"}, Values: [][]any{{1, "a"}}}
+		_, err := MapScalars[int64](rows)
+		assert.ErrorIs(t, err, errScalarColumns)
+	})
+	t.Run("should return error if rows.Column() errors", func(t *testing.T) {
+		rows := &mock.Rows{ColumnErr: fmt.Errorf("error")}
+		_, err := MapScalars[int64](rows)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if rows.Scan() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, Values: [][]any{{1}}, ScanErr: fmt.Errorf("error")}
+		_, err := MapScalars[int64](rows)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if rows.Err() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, Values: [][]any{{1}}, ErrErr: fmt.Errorf("error")}
+		_, err := MapScalars[int64](rows)
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestMapScalar(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM users`).WillReturnRows(
+			sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(3))
+		rows, err := mockDb.Query(`SELECT COUNT(*) FROM users`)
+		assert.NoError(t, err)
+		count, err := MapScalar[int64](rows)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+	t.Run("should return exql.ErrRecordNotFound if rows is empty", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}}
+		_, err := MapScalar[int64](rows)
+		assert.ErrorIs(t, err, ErrRecordNotFound{})
+	})
+	t.Run("should return error if columns are not single", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id", "name"}, Values: [][]any{{1, "a"}}}
+		_, err := MapScalar[int64](rows)
+		assert.ErrorIs(t, err, errScalarColumns)
+	})
+	t.Run("should return error if rows.Scan() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, Values: [][]any{{1}}, ScanErr: fmt.Errorf("error")}
+		_, err := MapScalar[int64](rows)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if rows.Err() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, ErrErr: fmt.Errorf("error")}
+		_, err := MapScalar[int64](rows)
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestMapMaps(t *testing.T) {
+	t.Run("should convert bytes by column types", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery(`SELECT \* FROM fields`).WillReturnRows(
+			sqlmock.NewRowsWithColumnDefinition(
+				sqlmock.NewColumn("int").OfType("INT", []byte{}),
+				sqlmock.NewColumn("uint").OfType("UNSIGNED BIGINT", []byte{}),
+				sqlmock.NewColumn("double").OfType("DOUBLE", []byte{}),
+				sqlmock.NewColumn("decimal").OfType("DECIMAL", []byte{}),
+				sqlmock.NewColumn("varchar").OfType("VARCHAR", []byte{}),
+				sqlmock.NewColumn("json").OfType("JSON", []byte{}),
+				sqlmock.NewColumn("blob").OfType("BLOB", []byte{}),
+				sqlmock.NewColumn("null").OfType("VARCHAR", []byte{}),
+			).AddRow(
+				[]byte("-1"), []byte("18446744073709551615"), []byte("1.5"), []byte("10.25"),
+				[]byte("name"), []byte(`{"a":1}`), []byte{0x00, 0x01}, nil,
+			))
+		rows, err := mockDb.Query(`SELECT * FROM fields`)
+		assert.NoError(t, err)
+		res, err := MapMaps(rows)
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{
+			"int":     int64(-1),
+			"uint":    uint64(18446744073709551615),
+			"double":  float64(1.5),
+			"decimal": "10.25",
+			"varchar": "name",
+			"json":    json.RawMessage(`{"a":1}`),
+			"blob":    []byte{0x00, 0x01},
+			"null":    nil,
+		}}, res)
+	})
+	t.Run("should keep values if column types are not available", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id", "name"}, Values: [][]any{{[]byte("1"), nil}}}
+		res, err := MapMaps(rows)
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"id": []byte("1"), "name": nil}}, res)
+	})
+	t.Run("should return error if value cannot be converted", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+		mock.ExpectQuery(`SELECT id FROM users`).WillReturnRows(
+			sqlmock.NewRowsWithColumnDefinition(
+				sqlmock.NewColumn("id").OfType("INT", []byte{}),
+			).AddRow([]byte("abc")))
+		rows, err := mockDb.Query(`SELECT id FROM users`)
+		assert.NoError(t, err)
+		_, err = MapMaps(rows)
+		assert.Error(t, err)
+	})
+	t.Run("should return exql.ErrRecordNotFound if rows is empty", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}}
+		_, err := MapMaps(rows)
+		assert.ErrorIs(t, err, ErrRecordNotFound{})
+	})
+	t.Run("should return error if rows.Column() errors", func(t *testing.T) {
+		rows := &mock.Rows{ColumnErr: fmt.Errorf("error")}
+		_, err := MapMaps(rows)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if rows.Scan() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, Values: [][]any{{1}}, ScanErr: fmt.Errorf("error")}
+		_, err := MapMaps(rows)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if rows.Err() errors", func(t *testing.T) {
+		rows := &mock.Rows{Cols: []string{"id"}, ErrErr: fmt.Errorf("error")}
+		_, err := MapMaps(rows)
+		assert.EqualError(t, err, "error")
+	})
+}
+
 func TestSerialMapper_Map(t *testing.T) {
 	db := testDb()
 	defer db.Close()
@@ -0,0 +1,31 @@
+// Package meta provides the static metadata of tables emitted with generated models.
+package meta
+
+// Table is the static metadata of a table.
+type Table struct {
+	// Name is the table name.
+	Name string
+	// Columns are the column names in the table order.
+	Columns []string
+	// PrimaryKey is the column names of the primary key in the key order.
+	PrimaryKey []string
+	// AutoIncrement is the name of the auto_increment column, or empty if none.
+	AutoIncrement string
+	// ReadOnly is true for tables that can't be written by models, such as views.
+	ReadOnly bool
+}
+
+// Provider is implemented by generated models to provide their metadata without reflection.
+type Provider interface {
+	TableMetadata() *Table
+}
+
+// HasColumn reports whether the table has the column.
+func (t *Table) HasColumn(name string) bool {
+	for _, c := range t.Columns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package meta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_HasColumn(t *testing.T) {
+	table := &Table{Name: "users", Columns: []string{"id", "name"}}
+	assert.True(t, table.HasColumn("id"))
+	assert.True(t, table.HasColumn("name"))
+	assert.False(t, table.HasColumn("age"))
+}
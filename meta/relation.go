@@ -0,0 +1,50 @@
+package meta
+
+// RelationKind is the kind of the relation between models.
+type RelationKind int
+
+const (
+	// BelongsTo is the relation that the parent refers the related model by its LocalKey.
+	// The destination field must be *Model.
+	BelongsTo RelationKind = iota + 1
+	// HasOne is the relation that the related model refers the parent by its ForeignKey.
+	// The destination field must be *Model.
+	HasOne
+	// HasMany is the relation that related models refer the parent by their ForeignKey.
+	// The destination field must be []*Model.
+	HasMany
+	// ManyToMany is the relation that the parent and related models are joined through the Through table.
+	// The destination field must be []*Model.
+	ManyToMany
+)
+
+// Relation describes how related models are loaded for parents.
+//
+// Example:
+//
+//	// group_users.user_id -> users.id
+//	&meta.Relation{Kind: meta.BelongsTo, LocalKey: "user_id", ForeignKey: "id"}
+//	// users.id <- group_users.user_id
+//	&meta.Relation{Kind: meta.HasMany, LocalKey: "id", ForeignKey: "user_id"}
+//	// users.id <- group_users.user_id, group_users.group_id -> user_groups.id
+//	&meta.Relation{
+//		Kind: meta.ManyToMany, LocalKey: "id",
+//		Through: "group_users", ForeignKey: "user_id", ThroughKey: "group_id", TargetKey: "id",
+//	}
+type Relation struct {
+	Kind RelationKind
+	// Table is the name of the related table.
+	// Default is TableName() of the related model.
+	Table string
+	// LocalKey is the column of the parent model.
+	LocalKey string
+	// ForeignKey is the column matched with LocalKey.
+	// It is the column of Table, or Through for ManyToMany.
+	ForeignKey string
+	// Through is the join table for ManyToMany.
+	Through string
+	// ThroughKey is the column of Through that refers TargetKey for ManyToMany.
+	ThroughKey string
+	// TargetKey is the column of Table referred by ThroughKey for ManyToMany.
+	TargetKey string
+}
@@ -0,0 +1,59 @@
+package exql
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/loilo-inc/exql/v3/meta"
+)
+
+// TableMetadataOf returns the static metadata of the model.
+// Generated models provide it by meta.Provider, otherwise it is built from exql tags of the struct.
+func TableMetadataOf(modelPtr Model) (*meta.Table, error) {
+	if modelPtr == nil {
+		return nil, errModelNil
+	}
+	if p, ok := modelPtr.(meta.Provider); ok {
+		return p.TableMetadata(), nil
+	}
+	tableName := modelPtr.TableName()
+	if tableName == "" {
+		return nil, errTableNameEmpty
+	}
+	t := reflect.TypeOf(modelPtr)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, errTypeNotStruct
+	}
+	table := &meta.Table{Name: tableName}
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("exql")
+		if tag == "" {
+			continue
+		}
+		tags, err := ParseTags(tag)
+		if err != nil {
+			return nil, err
+		}
+		if isRelationTags(tags) {
+			continue
+		}
+		colName := tags["column"]
+		if colName == "" {
+			return nil, fmt.Errorf("column tag is not set")
+		}
+		table.Columns = append(table.Columns, colName)
+		if _, ok := tags["primary"]; ok {
+			table.PrimaryKey = append(table.PrimaryKey, colName)
+		}
+		if _, ok := tags["auto_increment"]; ok {
+			table.AutoIncrement = colName
+		}
+	}
+	if len(table.Columns) == 0 {
+		return nil, fmt.Errorf("no exql tags in any fields")
+	}
+	return table, nil
+}
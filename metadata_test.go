@@ -0,0 +1,68 @@
+package exql
+
+import (
+	"testing"
+
+	"github.com/loilo-inc/exql/v3/meta"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/model/testmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+type metadataModel struct {
+	Pk1    string         `exql:"column:pk1;primary"`
+	Pk2    int64          `exql:"column:pk2;primary;auto_increment"`
+	Other  string         `exql:"column:other"`
+	Items  []*model.Users `exql:"has_many:users"`
+	NoTags string
+}
+
+func (metadataModel) TableName() string {
+	return "metadata"
+}
+
+func TestTableMetadataOf(t *testing.T) {
+	t.Run("generated", func(t *testing.T) {
+		m, err := TableMetadataOf(&model.UserLoginHistories{})
+		assert.NoError(t, err)
+		assert.Same(t, model.UserLoginHistoriesMetadata, m)
+		assert.Equal(t, &meta.Table{
+			Name:          "user_login_histories",
+			Columns:       []string{"id", "user_id", "created_at"},
+			PrimaryKey:    []string{"id", "created_at"},
+			AutoIncrement: "id",
+		}, m)
+	})
+	t.Run("tags", func(t *testing.T) {
+		m, err := TableMetadataOf(&metadataModel{})
+		assert.NoError(t, err)
+		assert.Equal(t, &meta.Table{
+			Name:          "metadata",
+			Columns:       []string{"pk1", "pk2", "other"},
+			PrimaryKey:    []string{"pk1", "pk2"},
+			AutoIncrement: "pk2",
+		}, m)
+	})
+	t.Run("errors", func(t *testing.T) {
+		_, err := TableMetadataOf(nil)
+		assert.ErrorIs(t, err, errModelNil)
+		_, err = TableMetadataOf(&testmodel.BadTableName{})
+		assert.ErrorIs(t, err, errTableNameEmpty)
+		_, err = TableMetadataOf(&testmodel.NoTag{})
+		assert.EqualError(t, err, "no exql tags in any fields")
+		_, err = TableMetadataOf(&testmodel.BadTag{})
+		assert.Error(t, err)
+	})
+	t.Run("no column tag", func(t *testing.T) {
+		_, err := TableMetadataOf(&noColumnTagModel{})
+		assert.EqualError(t, err, "column tag is not set")
+	})
+}
+
+type noColumnTagModel struct {
+	Id int64 `exql:"primary"`
+}
+
+func (noColumnTagModel) TableName() string {
+	return "dummy"
+}
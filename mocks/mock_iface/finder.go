@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: iface/finder.go
+//
+// Generated by this command:
+//
+//	mockgen -source iface/finder.go -destination ./mocks/mock_iface/finder.go -package mock_iface
+//
+
+// Package mock_iface is a generated GoMock package.
+package mock_iface
+
+import (
+	context "context"
+	reflect "reflect"
+
+	query "github.com/loilo-inc/exql/v3/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFinder is a mock of Finder interface.
+type MockFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockFinderMockRecorder
+	isgomock struct{}
+}
+
+// MockFinderMockRecorder is the mock recorder for MockFinder.
+type MockFinderMockRecorder struct {
+	mock *MockFinder
+}
+
+// NewMockFinder creates a new mock instance.
+func NewMockFinder(ctrl *gomock.Controller) *MockFinder {
+	mock := &MockFinder{ctrl: ctrl}
+	mock.recorder = &MockFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFinder) EXPECT() *MockFinderMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockFinder) Find(q query.Query, destPtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", q, destPtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockFinderMockRecorder) Find(q, destPtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockFinder)(nil).Find), q, destPtrOfStruct)
+}
+
+// FindContext mocks base method.
+func (m *MockFinder) FindContext(ctx context.Context, q query.Query, destPtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindContext", ctx, q, destPtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindContext indicates an expected call of FindContext.
+func (mr *MockFinderMockRecorder) FindContext(ctx, q, destPtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindContext", reflect.TypeOf((*MockFinder)(nil).FindContext), ctx, q, destPtrOfStruct)
+}
+
+// FindMany mocks base method.
+func (m *MockFinder) FindMany(q query.Query, destSlicePtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMany", q, destSlicePtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindMany indicates an expected call of FindMany.
+func (mr *MockFinderMockRecorder) FindMany(q, destSlicePtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockFinder)(nil).FindMany), q, destSlicePtrOfStruct)
+}
+
+// FindManyContext mocks base method.
+func (m *MockFinder) FindManyContext(ctx context.Context, q query.Query, destSlicePtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManyContext", ctx, q, destSlicePtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindManyContext indicates an expected call of FindManyContext.
+func (mr *MockFinderMockRecorder) FindManyContext(ctx, q, destSlicePtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManyContext", reflect.TypeOf((*MockFinder)(nil).FindManyContext), ctx, q, destSlicePtrOfStruct)
+}
+
+// MockEmptyFinder is a mock of EmptyFinder interface.
+type MockEmptyFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmptyFinderMockRecorder
+	isgomock struct{}
+}
+
+// MockEmptyFinderMockRecorder is the mock recorder for MockEmptyFinder.
+type MockEmptyFinderMockRecorder struct {
+	mock *MockEmptyFinder
+}
+
+// NewMockEmptyFinder creates a new mock instance.
+func NewMockEmptyFinder(ctrl *gomock.Controller) *MockEmptyFinder {
+	mock := &MockEmptyFinder{ctrl: ctrl}
+	mock.recorder = &MockEmptyFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmptyFinder) EXPECT() *MockEmptyFinderMockRecorder {
+	return m.recorder
+}
+
+// FindManyOrEmpty mocks base method.
+func (m *MockEmptyFinder) FindManyOrEmpty(q query.Query, destSlicePtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManyOrEmpty", q, destSlicePtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindManyOrEmpty indicates an expected call of FindManyOrEmpty.
+func (mr *MockEmptyFinderMockRecorder) FindManyOrEmpty(q, destSlicePtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManyOrEmpty", reflect.TypeOf((*MockEmptyFinder)(nil).FindManyOrEmpty), q, destSlicePtrOfStruct)
+}
+
+// FindManyOrEmptyContext mocks base method.
+func (m *MockEmptyFinder) FindManyOrEmptyContext(ctx context.Context, q query.Query, destSlicePtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManyOrEmptyContext", ctx, q, destSlicePtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindManyOrEmptyContext indicates an expected call of FindManyOrEmptyContext.
+func (mr *MockEmptyFinderMockRecorder) FindManyOrEmptyContext(ctx, q, destSlicePtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManyOrEmptyContext", reflect.TypeOf((*MockEmptyFinder)(nil).FindManyOrEmptyContext), ctx, q, destSlicePtrOfStruct)
+}
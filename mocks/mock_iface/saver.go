@@ -0,0 +1,544 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: iface/saver.go
+//
+// Generated by this command:
+//
+//	mockgen -source iface/saver.go -destination ./mocks/mock_iface/saver.go -package mock_iface
+//
+
+// Package mock_iface is a generated GoMock package.
+package mock_iface
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	iface "github.com/loilo-inc/exql/v3/iface"
+	query "github.com/loilo-inc/exql/v3/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSaver is a mock of Saver interface.
+type MockSaver struct {
+	ctrl     *gomock.Controller
+	recorder *MockSaverMockRecorder
+	isgomock struct{}
+}
+
+// MockSaverMockRecorder is the mock recorder for MockSaver.
+type MockSaverMockRecorder struct {
+	mock *MockSaver
+}
+
+// NewMockSaver creates a new mock instance.
+func NewMockSaver(ctrl *gomock.Controller) *MockSaver {
+	mock := &MockSaver{ctrl: ctrl}
+	mock.recorder = &MockSaverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSaver) EXPECT() *MockSaverMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSaver) Delete(table string, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", table, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSaverMockRecorder) Delete(table, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSaver)(nil).Delete), table, where)
+}
+
+// DeleteContext mocks base method.
+func (m *MockSaver) DeleteContext(ctx context.Context, table string, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContext", ctx, table, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteContext indicates an expected call of DeleteContext.
+func (mr *MockSaverMockRecorder) DeleteContext(ctx, table, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContext", reflect.TypeOf((*MockSaver)(nil).DeleteContext), ctx, table, where)
+}
+
+// Exec mocks base method.
+func (m *MockSaver) Exec(arg0 query.Query) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockSaverMockRecorder) Exec(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockSaver)(nil).Exec), arg0)
+}
+
+// ExecContext mocks base method.
+func (m *MockSaver) ExecContext(ctx context.Context, arg1 query.Query) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecContext", ctx, arg1)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContext indicates an expected call of ExecContext.
+func (mr *MockSaverMockRecorder) ExecContext(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockSaver)(nil).ExecContext), ctx, arg1)
+}
+
+// Insert mocks base method.
+func (m *MockSaver) Insert(structPtr iface.Model) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", structPtr)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSaverMockRecorder) Insert(structPtr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSaver)(nil).Insert), structPtr)
+}
+
+// InsertContext mocks base method.
+func (m *MockSaver) InsertContext(ctx context.Context, structPtr iface.Model) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertContext", ctx, structPtr)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertContext indicates an expected call of InsertContext.
+func (mr *MockSaverMockRecorder) InsertContext(ctx, structPtr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertContext", reflect.TypeOf((*MockSaver)(nil).InsertContext), ctx, structPtr)
+}
+
+// Query mocks base method.
+func (m *MockSaver) Query(arg0 query.Query) (*sql.Rows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0)
+	ret0, _ := ret[0].(*sql.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockSaverMockRecorder) Query(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockSaver)(nil).Query), arg0)
+}
+
+// QueryContext mocks base method.
+func (m *MockSaver) QueryContext(ctx context.Context, arg1 query.Query) (*sql.Rows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryContext", ctx, arg1)
+	ret0, _ := ret[0].(*sql.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryContext indicates an expected call of QueryContext.
+func (mr *MockSaverMockRecorder) QueryContext(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContext", reflect.TypeOf((*MockSaver)(nil).QueryContext), ctx, arg1)
+}
+
+// QueryRow mocks base method.
+func (m *MockSaver) QueryRow(arg0 query.Query) (*sql.Row, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryRow", arg0)
+	ret0, _ := ret[0].(*sql.Row)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryRow indicates an expected call of QueryRow.
+func (mr *MockSaverMockRecorder) QueryRow(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRow", reflect.TypeOf((*MockSaver)(nil).QueryRow), arg0)
+}
+
+// QueryRowContext mocks base method.
+func (m *MockSaver) QueryRowContext(ctx context.Context, arg1 query.Query) (*sql.Row, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryRowContext", ctx, arg1)
+	ret0, _ := ret[0].(*sql.Row)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryRowContext indicates an expected call of QueryRowContext.
+func (mr *MockSaverMockRecorder) QueryRowContext(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRowContext", reflect.TypeOf((*MockSaver)(nil).QueryRowContext), ctx, arg1)
+}
+
+// Update mocks base method.
+func (m *MockSaver) Update(table string, set map[string]any, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", table, set, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSaverMockRecorder) Update(table, set, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSaver)(nil).Update), table, set, where)
+}
+
+// UpdateContext mocks base method.
+func (m *MockSaver) UpdateContext(ctx context.Context, table string, set map[string]any, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContext", ctx, table, set, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContext indicates an expected call of UpdateContext.
+func (mr *MockSaverMockRecorder) UpdateContext(ctx, table, set, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContext", reflect.TypeOf((*MockSaver)(nil).UpdateContext), ctx, table, set, where)
+}
+
+// UpdateModel mocks base method.
+func (m *MockSaver) UpdateModel(updaterStructPtr iface.ModelUpdate, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModel", updaterStructPtr, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateModel indicates an expected call of UpdateModel.
+func (mr *MockSaverMockRecorder) UpdateModel(updaterStructPtr, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModel", reflect.TypeOf((*MockSaver)(nil).UpdateModel), updaterStructPtr, where)
+}
+
+// UpdateModelContext mocks base method.
+func (m *MockSaver) UpdateModelContext(ctx context.Context, updaterStructPtr iface.ModelUpdate, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModelContext", ctx, updaterStructPtr, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateModelContext indicates an expected call of UpdateModelContext.
+func (mr *MockSaverMockRecorder) UpdateModelContext(ctx, updaterStructPtr, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModelContext", reflect.TypeOf((*MockSaver)(nil).UpdateModelContext), ctx, updaterStructPtr, where)
+}
+
+// MockSaverFinder is a mock of SaverFinder interface.
+type MockSaverFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockSaverFinderMockRecorder
+	isgomock struct{}
+}
+
+// MockSaverFinderMockRecorder is the mock recorder for MockSaverFinder.
+type MockSaverFinderMockRecorder struct {
+	mock *MockSaverFinder
+}
+
+// NewMockSaverFinder creates a new mock instance.
+func NewMockSaverFinder(ctrl *gomock.Controller) *MockSaverFinder {
+	mock := &MockSaverFinder{ctrl: ctrl}
+	mock.recorder = &MockSaverFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSaverFinder) EXPECT() *MockSaverFinderMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSaverFinder) Delete(table string, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", table, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSaverFinderMockRecorder) Delete(table, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSaverFinder)(nil).Delete), table, where)
+}
+
+// DeleteContext mocks base method.
+func (m *MockSaverFinder) DeleteContext(ctx context.Context, table string, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContext", ctx, table, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteContext indicates an expected call of DeleteContext.
+func (mr *MockSaverFinderMockRecorder) DeleteContext(ctx, table, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContext", reflect.TypeOf((*MockSaverFinder)(nil).DeleteContext), ctx, table, where)
+}
+
+// Exec mocks base method.
+func (m *MockSaverFinder) Exec(arg0 query.Query) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockSaverFinderMockRecorder) Exec(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockSaverFinder)(nil).Exec), arg0)
+}
+
+// ExecContext mocks base method.
+func (m *MockSaverFinder) ExecContext(ctx context.Context, arg1 query.Query) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecContext", ctx, arg1)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContext indicates an expected call of ExecContext.
+func (mr *MockSaverFinderMockRecorder) ExecContext(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockSaverFinder)(nil).ExecContext), ctx, arg1)
+}
+
+// Find mocks base method.
+func (m *MockSaverFinder) Find(q query.Query, destPtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", q, destPtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSaverFinderMockRecorder) Find(q, destPtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSaverFinder)(nil).Find), q, destPtrOfStruct)
+}
+
+// FindContext mocks base method.
+func (m *MockSaverFinder) FindContext(ctx context.Context, q query.Query, destPtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindContext", ctx, q, destPtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindContext indicates an expected call of FindContext.
+func (mr *MockSaverFinderMockRecorder) FindContext(ctx, q, destPtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindContext", reflect.TypeOf((*MockSaverFinder)(nil).FindContext), ctx, q, destPtrOfStruct)
+}
+
+// FindMany mocks base method.
+func (m *MockSaverFinder) FindMany(q query.Query, destSlicePtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMany", q, destSlicePtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindMany indicates an expected call of FindMany.
+func (mr *MockSaverFinderMockRecorder) FindMany(q, destSlicePtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockSaverFinder)(nil).FindMany), q, destSlicePtrOfStruct)
+}
+
+// FindManyContext mocks base method.
+func (m *MockSaverFinder) FindManyContext(ctx context.Context, q query.Query, destSlicePtrOfStruct any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManyContext", ctx, q, destSlicePtrOfStruct)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindManyContext indicates an expected call of FindManyContext.
+func (mr *MockSaverFinderMockRecorder) FindManyContext(ctx, q, destSlicePtrOfStruct any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManyContext", reflect.TypeOf((*MockSaverFinder)(nil).FindManyContext), ctx, q, destSlicePtrOfStruct)
+}
+
+// Insert mocks base method.
+func (m *MockSaverFinder) Insert(structPtr iface.Model) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", structPtr)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSaverFinderMockRecorder) Insert(structPtr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSaverFinder)(nil).Insert), structPtr)
+}
+
+// InsertContext mocks base method.
+func (m *MockSaverFinder) InsertContext(ctx context.Context, structPtr iface.Model) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertContext", ctx, structPtr)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertContext indicates an expected call of InsertContext.
+func (mr *MockSaverFinderMockRecorder) InsertContext(ctx, structPtr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertContext", reflect.TypeOf((*MockSaverFinder)(nil).InsertContext), ctx, structPtr)
+}
+
+// Query mocks base method.
+func (m *MockSaverFinder) Query(arg0 query.Query) (*sql.Rows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0)
+	ret0, _ := ret[0].(*sql.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockSaverFinderMockRecorder) Query(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockSaverFinder)(nil).Query), arg0)
+}
+
+// QueryContext mocks base method.
+func (m *MockSaverFinder) QueryContext(ctx context.Context, arg1 query.Query) (*sql.Rows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryContext", ctx, arg1)
+	ret0, _ := ret[0].(*sql.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryContext indicates an expected call of QueryContext.
+func (mr *MockSaverFinderMockRecorder) QueryContext(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContext", reflect.TypeOf((*MockSaverFinder)(nil).QueryContext), ctx, arg1)
+}
+
+// QueryRow mocks base method.
+func (m *MockSaverFinder) QueryRow(arg0 query.Query) (*sql.Row, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryRow", arg0)
+	ret0, _ := ret[0].(*sql.Row)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryRow indicates an expected call of QueryRow.
+func (mr *MockSaverFinderMockRecorder) QueryRow(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRow", reflect.TypeOf((*MockSaverFinder)(nil).QueryRow), arg0)
+}
+
+// QueryRowContext mocks base method.
+func (m *MockSaverFinder) QueryRowContext(ctx context.Context, arg1 query.Query) (*sql.Row, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryRowContext", ctx, arg1)
+	ret0, _ := ret[0].(*sql.Row)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryRowContext indicates an expected call of QueryRowContext.
+func (mr *MockSaverFinderMockRecorder) QueryRowContext(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRowContext", reflect.TypeOf((*MockSaverFinder)(nil).QueryRowContext), ctx, arg1)
+}
+
+// Update mocks base method.
+func (m *MockSaverFinder) Update(table string, set map[string]any, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", table, set, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSaverFinderMockRecorder) Update(table, set, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSaverFinder)(nil).Update), table, set, where)
+}
+
+// UpdateContext mocks base method.
+func (m *MockSaverFinder) UpdateContext(ctx context.Context, table string, set map[string]any, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContext", ctx, table, set, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContext indicates an expected call of UpdateContext.
+func (mr *MockSaverFinderMockRecorder) UpdateContext(ctx, table, set, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContext", reflect.TypeOf((*MockSaverFinder)(nil).UpdateContext), ctx, table, set, where)
+}
+
+// UpdateModel mocks base method.
+func (m *MockSaverFinder) UpdateModel(updaterStructPtr iface.ModelUpdate, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModel", updaterStructPtr, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateModel indicates an expected call of UpdateModel.
+func (mr *MockSaverFinderMockRecorder) UpdateModel(updaterStructPtr, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModel", reflect.TypeOf((*MockSaverFinder)(nil).UpdateModel), updaterStructPtr, where)
+}
+
+// UpdateModelContext mocks base method.
+func (m *MockSaverFinder) UpdateModelContext(ctx context.Context, updaterStructPtr iface.ModelUpdate, where query.Condition) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModelContext", ctx, updaterStructPtr, where)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateModelContext indicates an expected call of UpdateModelContext.
+func (mr *MockSaverFinderMockRecorder) UpdateModelContext(ctx, updaterStructPtr, where any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModelContext", reflect.TypeOf((*MockSaverFinder)(nil).UpdateModelContext), ctx, updaterStructPtr, where)
+}
@@ -3,6 +3,7 @@ package exql
 import (
 	"fmt"
 	"reflect"
+	"sort"
 )
 
 type upsertModelSchema struct {
@@ -50,6 +51,9 @@ func parseUpsertSchema(t reflect.Type, forUpdate bool) (*upsertModelSchema, erro
 		if err != nil {
 			return nil, err
 		}
+		if isRelationTags(tags) {
+			continue
+		}
 		colName := tags["column"]
 		if colName == "" {
 			return nil, fmt.Errorf("column tag is not set")
@@ -100,6 +104,9 @@ func parseMapSchema(t reflect.Type) (*mapModelSchema, error) {
 		if err != nil {
 			return nil, err
 		}
+		if isRelationTags(tags) {
+			continue
+		}
 		colName := tags["column"]
 		if colName == "" {
 			return nil, fmt.Errorf("column tag is not set")
@@ -160,5 +167,23 @@ func (ms *mapModelSchema) createReceivers(
 	return destVals
 }
 
+// isRelationTags reports whether the field holds related models instead of a column.
+func isRelationTags(tags map[string]string) bool {
+	_, ok := tags[hasManyTag]
+	return ok
+}
+
+// columns returns column names in the order of struct fields.
+func (ms *mapModelSchema) columns() []string {
+	var cols []string
+	for col := range ms.fields {
+		cols = append(cols, col)
+	}
+	sort.Slice(cols, func(i, j int) bool {
+		return ms.fields[cols[i]] < ms.fields[cols[j]]
+	})
+	return cols
+}
+
 var errTypeNotStruct = fmt.Errorf("type must be struct")
 var errTableNameEmpty = fmt.Errorf("empty table name")
@@ -0,0 +1,61 @@
+// Code generated by exql. DO NOT EDIT.
+package factory
+
+import "context"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/model"
+
+// NewFields returns model.Fields with test values in NOT NULL columns, modified by overrides in order.
+// Values are unique in the process as possible by the sequence number.
+func NewFields(overrides ...func(*model.Fields)) *model.Fields {
+	seq := nextSeq()
+	m := &model.Fields{
+		TinyintField:           seq % 127,
+		TinyintUnsignedField:   seq % 255,
+		SmallintField:          seq % 32767,
+		SmallintUnsignedField:  seq % 65535,
+		MediumintField:         seq % 8388607,
+		MediumintUnsignedField: seq % 16777215,
+		IntField:               seq % 2147483647,
+		IntUnsignedField:       seq % 4294967295,
+		BigintField:            seq,
+		BigintUnsignedField:    uint64(seq),
+		FloatField:             float32(seq),
+		DoubleField:            float64(seq),
+		TinytextField:          seqString("tinytext_field", seq, 255),
+		MediumtextField:        seqString("mediumtext_field", seq, 255),
+		TextField:              seqString("text_field", seq, 255),
+		LongtextField:          seqString("longtext_field", seq, 255),
+		VarcharFiledField:      seqString("varchar_filed_field", seq, 255),
+		CharFiledField:         seqString("char_filed_field", seq, 10),
+		DateField:              seqTime(seq),
+		DatetimeField:          seqTime(seq),
+		TimeField:              "12:00:00",
+		TimestampField:         seqTime(seq),
+		TinyblobField:          []byte(seqString("tinyblob_field", seq, 255)),
+		MediumblobField:        []byte(seqString("mediumblob_field", seq, 255)),
+		BlobField:              []byte(seqString("blob_field", seq, 255)),
+		LongblobField:          []byte(seqString("longblob_field", seq, 255)),
+		JsonField:              []byte("{}"),
+		BoolField:              true,
+		DecimalField:           seqDecimal(seq, 8),
+		EnumField:              "a",
+		BitField:               []byte{1},
+		YearField:              2000 + seq%100,
+		BinaryField:            []byte(seqString("binary_field", seq, 4)),
+		VarbinaryField:         []byte(seqString("varbinary_field", seq, 255)),
+	}
+	for _, o := range overrides {
+		o(m)
+	}
+	return m
+}
+
+// InsertFields inserts model.Fields built by NewFields with overrides.
+func InsertFields(ctx context.Context, saver iface.Saver, overrides ...func(*model.Fields)) (*model.Fields, error) {
+	m := NewFields(overrides...)
+	if _, err := saver.InsertContext(ctx, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by exql. DO NOT EDIT.
+package factory
+
+import "context"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/model"
+
+// NewUserGroups returns model.UserGroups with test values in NOT NULL columns, modified by overrides in order.
+// Values are unique in the process as possible by the sequence number.
+func NewUserGroups(overrides ...func(*model.UserGroups)) *model.UserGroups {
+	seq := nextSeq()
+	m := &model.UserGroups{
+		Name: seqString("name", seq, 255),
+	}
+	for _, o := range overrides {
+		o(m)
+	}
+	return m
+}
+
+// InsertUserGroups inserts model.UserGroups built by NewUserGroups with overrides.
+func InsertUserGroups(ctx context.Context, saver iface.Saver, overrides ...func(*model.UserGroups)) (*model.UserGroups, error) {
+	m := NewUserGroups(overrides...)
+	if _, err := saver.InsertContext(ctx, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
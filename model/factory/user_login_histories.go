@@ -0,0 +1,29 @@
+// Code generated by exql. DO NOT EDIT.
+package factory
+
+import "context"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/model"
+
+// NewUserLoginHistories returns model.UserLoginHistories with test values in NOT NULL columns, modified by overrides in order.
+// Values are unique in the process as possible by the sequence number.
+func NewUserLoginHistories(overrides ...func(*model.UserLoginHistories)) *model.UserLoginHistories {
+	seq := nextSeq()
+	m := &model.UserLoginHistories{
+		UserId:    seq % 2147483647,
+		CreatedAt: seqTime(seq),
+	}
+	for _, o := range overrides {
+		o(m)
+	}
+	return m
+}
+
+// InsertUserLoginHistories inserts model.UserLoginHistories built by NewUserLoginHistories with overrides.
+func InsertUserLoginHistories(ctx context.Context, saver iface.Saver, overrides ...func(*model.UserLoginHistories)) (*model.UserLoginHistories, error) {
+	m := NewUserLoginHistories(overrides...)
+	if _, err := saver.InsertContext(ctx, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
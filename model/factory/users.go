@@ -0,0 +1,29 @@
+// Code generated by exql. DO NOT EDIT.
+package factory
+
+import "context"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/model"
+
+// NewUsers returns model.Users with test values in NOT NULL columns, modified by overrides in order.
+// Values are unique in the process as possible by the sequence number.
+func NewUsers(overrides ...func(*model.Users)) *model.Users {
+	seq := nextSeq()
+	m := &model.Users{
+		Name: seqString("name", seq, 255),
+		Age:  seq % 2147483647,
+	}
+	for _, o := range overrides {
+		o(m)
+	}
+	return m
+}
+
+// InsertUsers inserts model.Users built by NewUsers with overrides.
+func InsertUsers(ctx context.Context, saver iface.Saver, overrides ...func(*model.Users)) (*model.Users, error) {
+	m := NewUsers(overrides...)
+	if _, err := saver.InsertContext(ctx, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
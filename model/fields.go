@@ -1,66 +1,93 @@
 // Code generated by exql. DO NOT EDIT.
 package model
 
+import "bytes"
+import "context"
+import "database/sql/driver"
 import "encoding/json"
+import "fmt"
+import "reflect"
+import "strings"
 import "time"
+import "github.com/loilo-inc/exql/v3/decimal"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/meta"
 import "github.com/loilo-inc/exql/v3/null"
+import "github.com/loilo-inc/exql/v3/query"
 
 type Fields struct {
-	Id                             int64           `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
-	TinyintField                   int64           `exql:"column:tinyint_field;type:tinyint;not null" json:"tinyint_field"`
-	TinyintUnsignedField           int64           `exql:"column:tinyint_unsigned_field;type:tinyint unsigned;not null" json:"tinyint_unsigned_field"`
-	TinyintNullableField           null.Int64      `exql:"column:tinyint_nullable_field;type:tinyint" json:"tinyint_nullable_field"`
-	TinyintUnsignedNullableField   null.Int64      `exql:"column:tinyint_unsigned_nullable_field;type:tinyint unsigned" json:"tinyint_unsigned_nullable_field"`
-	SmallintField                  int64           `exql:"column:smallint_field;type:smallint;not null" json:"smallint_field"`
-	SmallintUnsignedField          int64           `exql:"column:smallint_unsigned_field;type:smallint unsigned;not null" json:"smallint_unsigned_field"`
-	SmallintNullableField          null.Int64      `exql:"column:smallint_nullable_field;type:smallint" json:"smallint_nullable_field"`
-	SmallintUnsignedNullableField  null.Int64      `exql:"column:smallint_unsigned_nullable_field;type:smallint unsigned" json:"smallint_unsigned_nullable_field"`
-	MediumintField                 int64           `exql:"column:mediumint_field;type:mediumint;not null" json:"mediumint_field"`
-	MediumintUnsignedField         int64           `exql:"column:mediumint_unsigned_field;type:mediumint unsigned;not null" json:"mediumint_unsigned_field"`
-	MediumintNullableField         null.Int64      `exql:"column:mediumint_nullable_field;type:mediumint" json:"mediumint_nullable_field"`
-	MediumintUnsignedNullableField null.Int64      `exql:"column:mediumint_unsigned_nullable_field;type:mediumint unsigned" json:"mediumint_unsigned_nullable_field"`
-	IntField                       int64           `exql:"column:int_field;type:int;not null" json:"int_field"`
-	IntUnsignedField               int64           `exql:"column:int_unsigned_field;type:int unsigned;not null" json:"int_unsigned_field"`
-	IntNullableField               null.Int64      `exql:"column:int_nullable_field;type:int" json:"int_nullable_field"`
-	IntUnsignedNullableField       null.Int64      `exql:"column:int_unsigned_nullable_field;type:int unsigned" json:"int_unsigned_nullable_field"`
-	BigintField                    int64           `exql:"column:bigint_field;type:bigint;not null" json:"bigint_field"`
-	BigintUnsignedField            uint64          `exql:"column:bigint_unsigned_field;type:bigint unsigned;not null" json:"bigint_unsigned_field"`
-	BigintNullableField            null.Int64      `exql:"column:bigint_nullable_field;type:bigint" json:"bigint_nullable_field"`
-	BigintUnsignedNullableField    null.Uint64     `exql:"column:bigint_unsigned_nullable_field;type:bigint unsigned" json:"bigint_unsigned_nullable_field"`
-	FloatField                     float32         `exql:"column:float_field;type:float;not null" json:"float_field"`
-	FloatNullField                 null.Float32    `exql:"column:float_null_field;type:float" json:"float_null_field"`
-	DoubleField                    float64         `exql:"column:double_field;type:double;not null" json:"double_field"`
-	DoubleNullField                null.Float64    `exql:"column:double_null_field;type:double" json:"double_null_field"`
-	TinytextField                  string          `exql:"column:tinytext_field;type:tinytext;not null" json:"tinytext_field"`
-	TinytextNullField              null.String     `exql:"column:tinytext_null_field;type:tinytext" json:"tinytext_null_field"`
-	MediumtextField                string          `exql:"column:mediumtext_field;type:mediumtext;not null" json:"mediumtext_field"`
-	MediumtextNullField            null.String     `exql:"column:mediumtext_null_field;type:mediumtext" json:"mediumtext_null_field"`
-	TextField                      string          `exql:"column:text_field;type:text;not null" json:"text_field"`
-	TextNullField                  null.String     `exql:"column:text_null_field;type:text" json:"text_null_field"`
-	LongtextField                  string          `exql:"column:longtext_field;type:longtext;not null" json:"longtext_field"`
-	LongtextNullField              null.String     `exql:"column:longtext_null_field;type:longtext" json:"longtext_null_field"`
-	VarcharFiledField              string          `exql:"column:varchar_filed_field;type:varchar(255);not null" json:"varchar_filed_field"`
-	VarcharNullField               null.String     `exql:"column:varchar_null_field;type:varchar(255)" json:"varchar_null_field"`
-	CharFiledField                 string          `exql:"column:char_filed_field;type:char(10);not null" json:"char_filed_field"`
-	CharFiledNullField             null.String     `exql:"column:char_filed_null_field;type:char(10)" json:"char_filed_null_field"`
-	DateField                      time.Time       `exql:"column:date_field;type:date;not null" json:"date_field"`
-	DateNullField                  null.Time       `exql:"column:date_null_field;type:date" json:"date_null_field"`
-	DatetimeField                  time.Time       `exql:"column:datetime_field;type:datetime;not null" json:"datetime_field"`
-	DatetimeNullField              null.Time       `exql:"column:datetime_null_field;type:datetime" json:"datetime_null_field"`
-	TimeField                      string          `exql:"column:time_field;type:time;not null" json:"time_field"`
-	TimeNullField                  null.String     `exql:"column:time_null_field;type:time" json:"time_null_field"`
-	TimestampField                 time.Time       `exql:"column:timestamp_field;type:timestamp;not null" json:"timestamp_field"`
-	TimestampNullField             null.Time       `exql:"column:timestamp_null_field;type:timestamp" json:"timestamp_null_field"`
-	TinyblobField                  []byte          `exql:"column:tinyblob_field;type:tinyblob;not null" json:"tinyblob_field"`
-	TinyblobNullField              null.Bytes      `exql:"column:tinyblob_null_field;type:tinyblob" json:"tinyblob_null_field"`
-	MediumblobField                []byte          `exql:"column:mediumblob_field;type:mediumblob;not null" json:"mediumblob_field"`
-	MediumblobNullField            null.Bytes      `exql:"column:mediumblob_null_field;type:mediumblob" json:"mediumblob_null_field"`
-	BlobField                      []byte          `exql:"column:blob_field;type:blob;not null" json:"blob_field"`
-	BlobNullField                  null.Bytes      `exql:"column:blob_null_field;type:blob" json:"blob_null_field"`
-	LongblobField                  []byte          `exql:"column:longblob_field;type:longblob;not null" json:"longblob_field"`
-	LongblobNullField              null.Bytes      `exql:"column:longblob_null_field;type:longblob" json:"longblob_null_field"`
-	JsonField                      json.RawMessage `exql:"column:json_field;type:json;not null" json:"json_field"`
-	JsonNullField                  null.JSON       `exql:"column:json_null_field;type:json" json:"json_null_field"`
+	Id                             int64                              `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
+	TinyintField                   int64                              `exql:"column:tinyint_field;type:tinyint;not null" json:"tinyint_field"`
+	TinyintUnsignedField           int64                              `exql:"column:tinyint_unsigned_field;type:tinyint unsigned;not null" json:"tinyint_unsigned_field"`
+	TinyintNullableField           null.Int64                         `exql:"column:tinyint_nullable_field;type:tinyint" json:"tinyint_nullable_field"`
+	TinyintUnsignedNullableField   null.Int64                         `exql:"column:tinyint_unsigned_nullable_field;type:tinyint unsigned" json:"tinyint_unsigned_nullable_field"`
+	SmallintField                  int64                              `exql:"column:smallint_field;type:smallint;not null" json:"smallint_field"`
+	SmallintUnsignedField          int64                              `exql:"column:smallint_unsigned_field;type:smallint unsigned;not null" json:"smallint_unsigned_field"`
+	SmallintNullableField          null.Int64                         `exql:"column:smallint_nullable_field;type:smallint" json:"smallint_nullable_field"`
+	SmallintUnsignedNullableField  null.Int64                         `exql:"column:smallint_unsigned_nullable_field;type:smallint unsigned" json:"smallint_unsigned_nullable_field"`
+	MediumintField                 int64                              `exql:"column:mediumint_field;type:mediumint;not null" json:"mediumint_field"`
+	MediumintUnsignedField         int64                              `exql:"column:mediumint_unsigned_field;type:mediumint unsigned;not null" json:"mediumint_unsigned_field"`
+	MediumintNullableField         null.Int64                         `exql:"column:mediumint_nullable_field;type:mediumint" json:"mediumint_nullable_field"`
+	MediumintUnsignedNullableField null.Int64                         `exql:"column:mediumint_unsigned_nullable_field;type:mediumint unsigned" json:"mediumint_unsigned_nullable_field"`
+	IntField                       int64                              `exql:"column:int_field;type:int;not null" json:"int_field"`
+	IntUnsignedField               int64                              `exql:"column:int_unsigned_field;type:int unsigned;not null" json:"int_unsigned_field"`
+	IntNullableField               null.Int64                         `exql:"column:int_nullable_field;type:int" json:"int_nullable_field"`
+	IntUnsignedNullableField       null.Int64                         `exql:"column:int_unsigned_nullable_field;type:int unsigned" json:"int_unsigned_nullable_field"`
+	BigintField                    int64                              `exql:"column:bigint_field;type:bigint;not null" json:"bigint_field"`
+	BigintUnsignedField            uint64                             `exql:"column:bigint_unsigned_field;type:bigint unsigned;not null" json:"bigint_unsigned_field"`
+	BigintNullableField            null.Int64                         `exql:"column:bigint_nullable_field;type:bigint" json:"bigint_nullable_field"`
+	BigintUnsignedNullableField    null.Uint64                        `exql:"column:bigint_unsigned_nullable_field;type:bigint unsigned" json:"bigint_unsigned_nullable_field"`
+	FloatField                     float32                            `exql:"column:float_field;type:float;not null" json:"float_field"`
+	FloatNullField                 null.Float32                       `exql:"column:float_null_field;type:float" json:"float_null_field"`
+	DoubleField                    float64                            `exql:"column:double_field;type:double;not null" json:"double_field"`
+	DoubleNullField                null.Float64                       `exql:"column:double_null_field;type:double" json:"double_null_field"`
+	TinytextField                  string                             `exql:"column:tinytext_field;type:tinytext;not null" json:"tinytext_field"`
+	TinytextNullField              null.String                        `exql:"column:tinytext_null_field;type:tinytext" json:"tinytext_null_field"`
+	MediumtextField                string                             `exql:"column:mediumtext_field;type:mediumtext;not null" json:"mediumtext_field"`
+	MediumtextNullField            null.String                        `exql:"column:mediumtext_null_field;type:mediumtext" json:"mediumtext_null_field"`
+	TextField                      string                             `exql:"column:text_field;type:text;not null" json:"text_field"`
+	TextNullField                  null.String                        `exql:"column:text_null_field;type:text" json:"text_null_field"`
+	LongtextField                  string                             `exql:"column:longtext_field;type:longtext;not null" json:"longtext_field"`
+	LongtextNullField              null.String                        `exql:"column:longtext_null_field;type:longtext" json:"longtext_null_field"`
+	VarcharFiledField              string                             `exql:"column:varchar_filed_field;type:varchar(255);not null" json:"varchar_filed_field"`
+	VarcharNullField               null.String                        `exql:"column:varchar_null_field;type:varchar(255)" json:"varchar_null_field"`
+	CharFiledField                 string                             `exql:"column:char_filed_field;type:char(10);not null" json:"char_filed_field"`
+	CharFiledNullField             null.String                        `exql:"column:char_filed_null_field;type:char(10)" json:"char_filed_null_field"`
+	DateField                      time.Time                          `exql:"column:date_field;type:date;not null" json:"date_field"`
+	DateNullField                  null.Time                          `exql:"column:date_null_field;type:date" json:"date_null_field"`
+	DatetimeField                  time.Time                          `exql:"column:datetime_field;type:datetime;not null" json:"datetime_field"`
+	DatetimeNullField              null.Time                          `exql:"column:datetime_null_field;type:datetime" json:"datetime_null_field"`
+	TimeField                      string                             `exql:"column:time_field;type:time;not null" json:"time_field"`
+	TimeNullField                  null.String                        `exql:"column:time_null_field;type:time" json:"time_null_field"`
+	TimestampField                 time.Time                          `exql:"column:timestamp_field;type:timestamp;not null" json:"timestamp_field"`
+	TimestampNullField             null.Time                          `exql:"column:timestamp_null_field;type:timestamp" json:"timestamp_null_field"`
+	TinyblobField                  []byte                             `exql:"column:tinyblob_field;type:tinyblob;not null" json:"tinyblob_field"`
+	TinyblobNullField              null.Bytes                         `exql:"column:tinyblob_null_field;type:tinyblob" json:"tinyblob_null_field"`
+	MediumblobField                []byte                             `exql:"column:mediumblob_field;type:mediumblob;not null" json:"mediumblob_field"`
+	MediumblobNullField            null.Bytes                         `exql:"column:mediumblob_null_field;type:mediumblob" json:"mediumblob_null_field"`
+	BlobField                      []byte                             `exql:"column:blob_field;type:blob;not null" json:"blob_field"`
+	BlobNullField                  null.Bytes                         `exql:"column:blob_null_field;type:blob" json:"blob_null_field"`
+	LongblobField                  []byte                             `exql:"column:longblob_field;type:longblob;not null" json:"longblob_field"`
+	LongblobNullField              null.Bytes                         `exql:"column:longblob_null_field;type:longblob" json:"longblob_null_field"`
+	JsonField                      json.RawMessage                    `exql:"column:json_field;type:json;not null" json:"json_field"`
+	JsonNullField                  null.JSON                          `exql:"column:json_null_field;type:json" json:"json_null_field"`
+	BoolField                      bool                               `exql:"column:bool_field;type:tinyint(1);not null" json:"bool_field"`
+	BoolNullField                  null.Bool                          `exql:"column:bool_null_field;type:tinyint(1)" json:"bool_null_field"`
+	DecimalField                   decimal.Decimal                    `exql:"column:decimal_field;type:decimal(10,2);not null" json:"decimal_field"`
+	DecimalNullField               null.Decimal                       `exql:"column:decimal_null_field;type:decimal(10,2)" json:"decimal_null_field"`
+	EnumField                      FieldsEnumFieldEnum                `exql:"column:enum_field;type:enum('a','b');not null" json:"enum_field"`
+	EnumNullField                  null.Null[FieldsEnumNullFieldEnum] `exql:"column:enum_null_field;type:enum('a','b')" json:"enum_null_field"`
+	SetField                       FieldsSetFieldSet                  `exql:"column:set_field;type:set('a','b');not null" json:"set_field"`
+	SetNullField                   null.Null[FieldsSetNullFieldSet]   `exql:"column:set_null_field;type:set('a','b')" json:"set_null_field"`
+	BitField                       []byte                             `exql:"column:bit_field;type:bit(8);not null" json:"bit_field"`
+	BitNullField                   null.Bytes                         `exql:"column:bit_null_field;type:bit(8)" json:"bit_null_field"`
+	YearField                      int64                              `exql:"column:year_field;type:year;not null" json:"year_field"`
+	YearNullField                  null.Int64                         `exql:"column:year_null_field;type:year" json:"year_null_field"`
+	BinaryField                    []byte                             `exql:"column:binary_field;type:binary(4);not null" json:"binary_field"`
+	BinaryNullField                null.Bytes                         `exql:"column:binary_null_field;type:binary(4)" json:"binary_null_field"`
+	VarbinaryField                 []byte                             `exql:"column:varbinary_field;type:varbinary(255);not null" json:"varbinary_field"`
+	VarbinaryNullField             null.Bytes                         `exql:"column:varbinary_null_field;type:varbinary(255)" json:"varbinary_null_field"`
+	GeometryNullField              null.Bytes                         `exql:"column:geometry_null_field;type:geometry" json:"geometry_null_field"`
 }
 
 func (f *Fields) TableName() string {
@@ -68,65 +95,1686 @@ func (f *Fields) TableName() string {
 }
 
 type UpdateFields struct {
-	Id                             *int64           `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
-	TinyintField                   *int64           `exql:"column:tinyint_field;type:tinyint;not null" json:"tinyint_field"`
-	TinyintUnsignedField           *int64           `exql:"column:tinyint_unsigned_field;type:tinyint unsigned;not null" json:"tinyint_unsigned_field"`
-	TinyintNullableField           *null.Int64      `exql:"column:tinyint_nullable_field;type:tinyint" json:"tinyint_nullable_field"`
-	TinyintUnsignedNullableField   *null.Int64      `exql:"column:tinyint_unsigned_nullable_field;type:tinyint unsigned" json:"tinyint_unsigned_nullable_field"`
-	SmallintField                  *int64           `exql:"column:smallint_field;type:smallint;not null" json:"smallint_field"`
-	SmallintUnsignedField          *int64           `exql:"column:smallint_unsigned_field;type:smallint unsigned;not null" json:"smallint_unsigned_field"`
-	SmallintNullableField          *null.Int64      `exql:"column:smallint_nullable_field;type:smallint" json:"smallint_nullable_field"`
-	SmallintUnsignedNullableField  *null.Int64      `exql:"column:smallint_unsigned_nullable_field;type:smallint unsigned" json:"smallint_unsigned_nullable_field"`
-	MediumintField                 *int64           `exql:"column:mediumint_field;type:mediumint;not null" json:"mediumint_field"`
-	MediumintUnsignedField         *int64           `exql:"column:mediumint_unsigned_field;type:mediumint unsigned;not null" json:"mediumint_unsigned_field"`
-	MediumintNullableField         *null.Int64      `exql:"column:mediumint_nullable_field;type:mediumint" json:"mediumint_nullable_field"`
-	MediumintUnsignedNullableField *null.Int64      `exql:"column:mediumint_unsigned_nullable_field;type:mediumint unsigned" json:"mediumint_unsigned_nullable_field"`
-	IntField                       *int64           `exql:"column:int_field;type:int;not null" json:"int_field"`
-	IntUnsignedField               *int64           `exql:"column:int_unsigned_field;type:int unsigned;not null" json:"int_unsigned_field"`
-	IntNullableField               *null.Int64      `exql:"column:int_nullable_field;type:int" json:"int_nullable_field"`
-	IntUnsignedNullableField       *null.Int64      `exql:"column:int_unsigned_nullable_field;type:int unsigned" json:"int_unsigned_nullable_field"`
-	BigintField                    *int64           `exql:"column:bigint_field;type:bigint;not null" json:"bigint_field"`
-	BigintUnsignedField            *uint64          `exql:"column:bigint_unsigned_field;type:bigint unsigned;not null" json:"bigint_unsigned_field"`
-	BigintNullableField            *null.Int64      `exql:"column:bigint_nullable_field;type:bigint" json:"bigint_nullable_field"`
-	BigintUnsignedNullableField    *null.Uint64     `exql:"column:bigint_unsigned_nullable_field;type:bigint unsigned" json:"bigint_unsigned_nullable_field"`
-	FloatField                     *float32         `exql:"column:float_field;type:float;not null" json:"float_field"`
-	FloatNullField                 *null.Float32    `exql:"column:float_null_field;type:float" json:"float_null_field"`
-	DoubleField                    *float64         `exql:"column:double_field;type:double;not null" json:"double_field"`
-	DoubleNullField                *null.Float64    `exql:"column:double_null_field;type:double" json:"double_null_field"`
-	TinytextField                  *string          `exql:"column:tinytext_field;type:tinytext;not null" json:"tinytext_field"`
-	TinytextNullField              *null.String     `exql:"column:tinytext_null_field;type:tinytext" json:"tinytext_null_field"`
-	MediumtextField                *string          `exql:"column:mediumtext_field;type:mediumtext;not null" json:"mediumtext_field"`
-	MediumtextNullField            *null.String     `exql:"column:mediumtext_null_field;type:mediumtext" json:"mediumtext_null_field"`
-	TextField                      *string          `exql:"column:text_field;type:text;not null" json:"text_field"`
-	TextNullField                  *null.String     `exql:"column:text_null_field;type:text" json:"text_null_field"`
-	LongtextField                  *string          `exql:"column:longtext_field;type:longtext;not null" json:"longtext_field"`
-	LongtextNullField              *null.String     `exql:"column:longtext_null_field;type:longtext" json:"longtext_null_field"`
-	VarcharFiledField              *string          `exql:"column:varchar_filed_field;type:varchar(255);not null" json:"varchar_filed_field"`
-	VarcharNullField               *null.String     `exql:"column:varchar_null_field;type:varchar(255)" json:"varchar_null_field"`
-	CharFiledField                 *string          `exql:"column:char_filed_field;type:char(10);not null" json:"char_filed_field"`
-	CharFiledNullField             *null.String     `exql:"column:char_filed_null_field;type:char(10)" json:"char_filed_null_field"`
-	DateField                      *time.Time       `exql:"column:date_field;type:date;not null" json:"date_field"`
-	DateNullField                  *null.Time       `exql:"column:date_null_field;type:date" json:"date_null_field"`
-	DatetimeField                  *time.Time       `exql:"column:datetime_field;type:datetime;not null" json:"datetime_field"`
-	DatetimeNullField              *null.Time       `exql:"column:datetime_null_field;type:datetime" json:"datetime_null_field"`
-	TimeField                      *string          `exql:"column:time_field;type:time;not null" json:"time_field"`
-	TimeNullField                  *null.String     `exql:"column:time_null_field;type:time" json:"time_null_field"`
-	TimestampField                 *time.Time       `exql:"column:timestamp_field;type:timestamp;not null" json:"timestamp_field"`
-	TimestampNullField             *null.Time       `exql:"column:timestamp_null_field;type:timestamp" json:"timestamp_null_field"`
-	TinyblobField                  *[]byte          `exql:"column:tinyblob_field;type:tinyblob;not null" json:"tinyblob_field"`
-	TinyblobNullField              *null.Bytes      `exql:"column:tinyblob_null_field;type:tinyblob" json:"tinyblob_null_field"`
-	MediumblobField                *[]byte          `exql:"column:mediumblob_field;type:mediumblob;not null" json:"mediumblob_field"`
-	MediumblobNullField            *null.Bytes      `exql:"column:mediumblob_null_field;type:mediumblob" json:"mediumblob_null_field"`
-	BlobField                      *[]byte          `exql:"column:blob_field;type:blob;not null" json:"blob_field"`
-	BlobNullField                  *null.Bytes      `exql:"column:blob_null_field;type:blob" json:"blob_null_field"`
-	LongblobField                  *[]byte          `exql:"column:longblob_field;type:longblob;not null" json:"longblob_field"`
-	LongblobNullField              *null.Bytes      `exql:"column:longblob_null_field;type:longblob" json:"longblob_null_field"`
-	JsonField                      *json.RawMessage `exql:"column:json_field;type:json;not null" json:"json_field"`
-	JsonNullField                  *null.JSON       `exql:"column:json_null_field;type:json" json:"json_null_field"`
+	TinyintField                   *int64                              `exql:"column:tinyint_field;type:tinyint;not null" json:"tinyint_field"`
+	TinyintUnsignedField           *int64                              `exql:"column:tinyint_unsigned_field;type:tinyint unsigned;not null" json:"tinyint_unsigned_field"`
+	TinyintNullableField           *null.Int64                         `exql:"column:tinyint_nullable_field;type:tinyint" json:"tinyint_nullable_field"`
+	TinyintUnsignedNullableField   *null.Int64                         `exql:"column:tinyint_unsigned_nullable_field;type:tinyint unsigned" json:"tinyint_unsigned_nullable_field"`
+	SmallintField                  *int64                              `exql:"column:smallint_field;type:smallint;not null" json:"smallint_field"`
+	SmallintUnsignedField          *int64                              `exql:"column:smallint_unsigned_field;type:smallint unsigned;not null" json:"smallint_unsigned_field"`
+	SmallintNullableField          *null.Int64                         `exql:"column:smallint_nullable_field;type:smallint" json:"smallint_nullable_field"`
+	SmallintUnsignedNullableField  *null.Int64                         `exql:"column:smallint_unsigned_nullable_field;type:smallint unsigned" json:"smallint_unsigned_nullable_field"`
+	MediumintField                 *int64                              `exql:"column:mediumint_field;type:mediumint;not null" json:"mediumint_field"`
+	MediumintUnsignedField         *int64                              `exql:"column:mediumint_unsigned_field;type:mediumint unsigned;not null" json:"mediumint_unsigned_field"`
+	MediumintNullableField         *null.Int64                         `exql:"column:mediumint_nullable_field;type:mediumint" json:"mediumint_nullable_field"`
+	MediumintUnsignedNullableField *null.Int64                         `exql:"column:mediumint_unsigned_nullable_field;type:mediumint unsigned" json:"mediumint_unsigned_nullable_field"`
+	IntField                       *int64                              `exql:"column:int_field;type:int;not null" json:"int_field"`
+	IntUnsignedField               *int64                              `exql:"column:int_unsigned_field;type:int unsigned;not null" json:"int_unsigned_field"`
+	IntNullableField               *null.Int64                         `exql:"column:int_nullable_field;type:int" json:"int_nullable_field"`
+	IntUnsignedNullableField       *null.Int64                         `exql:"column:int_unsigned_nullable_field;type:int unsigned" json:"int_unsigned_nullable_field"`
+	BigintField                    *int64                              `exql:"column:bigint_field;type:bigint;not null" json:"bigint_field"`
+	BigintUnsignedField            *uint64                             `exql:"column:bigint_unsigned_field;type:bigint unsigned;not null" json:"bigint_unsigned_field"`
+	BigintNullableField            *null.Int64                         `exql:"column:bigint_nullable_field;type:bigint" json:"bigint_nullable_field"`
+	BigintUnsignedNullableField    *null.Uint64                        `exql:"column:bigint_unsigned_nullable_field;type:bigint unsigned" json:"bigint_unsigned_nullable_field"`
+	FloatField                     *float32                            `exql:"column:float_field;type:float;not null" json:"float_field"`
+	FloatNullField                 *null.Float32                       `exql:"column:float_null_field;type:float" json:"float_null_field"`
+	DoubleField                    *float64                            `exql:"column:double_field;type:double;not null" json:"double_field"`
+	DoubleNullField                *null.Float64                       `exql:"column:double_null_field;type:double" json:"double_null_field"`
+	TinytextField                  *string                             `exql:"column:tinytext_field;type:tinytext;not null" json:"tinytext_field"`
+	TinytextNullField              *null.String                        `exql:"column:tinytext_null_field;type:tinytext" json:"tinytext_null_field"`
+	MediumtextField                *string                             `exql:"column:mediumtext_field;type:mediumtext;not null" json:"mediumtext_field"`
+	MediumtextNullField            *null.String                        `exql:"column:mediumtext_null_field;type:mediumtext" json:"mediumtext_null_field"`
+	TextField                      *string                             `exql:"column:text_field;type:text;not null" json:"text_field"`
+	TextNullField                  *null.String                        `exql:"column:text_null_field;type:text" json:"text_null_field"`
+	LongtextField                  *string                             `exql:"column:longtext_field;type:longtext;not null" json:"longtext_field"`
+	LongtextNullField              *null.String                        `exql:"column:longtext_null_field;type:longtext" json:"longtext_null_field"`
+	VarcharFiledField              *string                             `exql:"column:varchar_filed_field;type:varchar(255);not null" json:"varchar_filed_field"`
+	VarcharNullField               *null.String                        `exql:"column:varchar_null_field;type:varchar(255)" json:"varchar_null_field"`
+	CharFiledField                 *string                             `exql:"column:char_filed_field;type:char(10);not null" json:"char_filed_field"`
+	CharFiledNullField             *null.String                        `exql:"column:char_filed_null_field;type:char(10)" json:"char_filed_null_field"`
+	DateField                      *time.Time                          `exql:"column:date_field;type:date;not null" json:"date_field"`
+	DateNullField                  *null.Time                          `exql:"column:date_null_field;type:date" json:"date_null_field"`
+	DatetimeField                  *time.Time                          `exql:"column:datetime_field;type:datetime;not null" json:"datetime_field"`
+	DatetimeNullField              *null.Time                          `exql:"column:datetime_null_field;type:datetime" json:"datetime_null_field"`
+	TimeField                      *string                             `exql:"column:time_field;type:time;not null" json:"time_field"`
+	TimeNullField                  *null.String                        `exql:"column:time_null_field;type:time" json:"time_null_field"`
+	TimestampField                 *time.Time                          `exql:"column:timestamp_field;type:timestamp;not null" json:"timestamp_field"`
+	TimestampNullField             *null.Time                          `exql:"column:timestamp_null_field;type:timestamp" json:"timestamp_null_field"`
+	TinyblobField                  *[]byte                             `exql:"column:tinyblob_field;type:tinyblob;not null" json:"tinyblob_field"`
+	TinyblobNullField              *null.Bytes                         `exql:"column:tinyblob_null_field;type:tinyblob" json:"tinyblob_null_field"`
+	MediumblobField                *[]byte                             `exql:"column:mediumblob_field;type:mediumblob;not null" json:"mediumblob_field"`
+	MediumblobNullField            *null.Bytes                         `exql:"column:mediumblob_null_field;type:mediumblob" json:"mediumblob_null_field"`
+	BlobField                      *[]byte                             `exql:"column:blob_field;type:blob;not null" json:"blob_field"`
+	BlobNullField                  *null.Bytes                         `exql:"column:blob_null_field;type:blob" json:"blob_null_field"`
+	LongblobField                  *[]byte                             `exql:"column:longblob_field;type:longblob;not null" json:"longblob_field"`
+	LongblobNullField              *null.Bytes                         `exql:"column:longblob_null_field;type:longblob" json:"longblob_null_field"`
+	JsonField                      *json.RawMessage                    `exql:"column:json_field;type:json;not null" json:"json_field"`
+	JsonNullField                  *null.JSON                          `exql:"column:json_null_field;type:json" json:"json_null_field"`
+	BoolField                      *bool                               `exql:"column:bool_field;type:tinyint(1);not null" json:"bool_field"`
+	BoolNullField                  *null.Bool                          `exql:"column:bool_null_field;type:tinyint(1)" json:"bool_null_field"`
+	DecimalField                   *decimal.Decimal                    `exql:"column:decimal_field;type:decimal(10,2);not null" json:"decimal_field"`
+	DecimalNullField               *null.Decimal                       `exql:"column:decimal_null_field;type:decimal(10,2)" json:"decimal_null_field"`
+	EnumField                      *FieldsEnumFieldEnum                `exql:"column:enum_field;type:enum('a','b');not null" json:"enum_field"`
+	EnumNullField                  *null.Null[FieldsEnumNullFieldEnum] `exql:"column:enum_null_field;type:enum('a','b')" json:"enum_null_field"`
+	SetField                       *FieldsSetFieldSet                  `exql:"column:set_field;type:set('a','b');not null" json:"set_field"`
+	SetNullField                   *null.Null[FieldsSetNullFieldSet]   `exql:"column:set_null_field;type:set('a','b')" json:"set_null_field"`
+	BitField                       *[]byte                             `exql:"column:bit_field;type:bit(8);not null" json:"bit_field"`
+	BitNullField                   *null.Bytes                         `exql:"column:bit_null_field;type:bit(8)" json:"bit_null_field"`
+	YearField                      *int64                              `exql:"column:year_field;type:year;not null" json:"year_field"`
+	YearNullField                  *null.Int64                         `exql:"column:year_null_field;type:year" json:"year_null_field"`
+	BinaryField                    *[]byte                             `exql:"column:binary_field;type:binary(4);not null" json:"binary_field"`
+	BinaryNullField                *null.Bytes                         `exql:"column:binary_null_field;type:binary(4)" json:"binary_null_field"`
+	VarbinaryField                 *[]byte                             `exql:"column:varbinary_field;type:varbinary(255);not null" json:"varbinary_field"`
+	VarbinaryNullField             *null.Bytes                         `exql:"column:varbinary_null_field;type:varbinary(255)" json:"varbinary_null_field"`
+	GeometryNullField              *null.Bytes                         `exql:"column:geometry_null_field;type:geometry" json:"geometry_null_field"`
 }
 
 func (f *UpdateFields) UpdateTableName() string {
 	return FieldsTableName
 }
 
+// NewUpdateFields returns the empty UpdateFields to set columns to be updated by setters.
+func NewUpdateFields() *UpdateFields {
+	return &UpdateFields{}
+}
+
+// SetTinyintField sets tinyint_field to be updated.
+func (f *UpdateFields) SetTinyintField(v int64) *UpdateFields {
+	f.TinyintField = &v
+	return f
+}
+
+// SetTinyintUnsignedField sets tinyint_unsigned_field to be updated.
+func (f *UpdateFields) SetTinyintUnsignedField(v int64) *UpdateFields {
+	f.TinyintUnsignedField = &v
+	return f
+}
+
+// SetTinyintNullableField sets tinyint_nullable_field to be updated.
+func (f *UpdateFields) SetTinyintNullableField(v null.Int64) *UpdateFields {
+	f.TinyintNullableField = &v
+	return f
+}
+
+// SetNullTinyintNullableField sets tinyint_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullTinyintNullableField() *UpdateFields {
+	var v null.Int64
+	f.TinyintNullableField = &v
+	return f
+}
+
+// SetTinyintUnsignedNullableField sets tinyint_unsigned_nullable_field to be updated.
+func (f *UpdateFields) SetTinyintUnsignedNullableField(v null.Int64) *UpdateFields {
+	f.TinyintUnsignedNullableField = &v
+	return f
+}
+
+// SetNullTinyintUnsignedNullableField sets tinyint_unsigned_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullTinyintUnsignedNullableField() *UpdateFields {
+	var v null.Int64
+	f.TinyintUnsignedNullableField = &v
+	return f
+}
+
+// SetSmallintField sets smallint_field to be updated.
+func (f *UpdateFields) SetSmallintField(v int64) *UpdateFields {
+	f.SmallintField = &v
+	return f
+}
+
+// SetSmallintUnsignedField sets smallint_unsigned_field to be updated.
+func (f *UpdateFields) SetSmallintUnsignedField(v int64) *UpdateFields {
+	f.SmallintUnsignedField = &v
+	return f
+}
+
+// SetSmallintNullableField sets smallint_nullable_field to be updated.
+func (f *UpdateFields) SetSmallintNullableField(v null.Int64) *UpdateFields {
+	f.SmallintNullableField = &v
+	return f
+}
+
+// SetNullSmallintNullableField sets smallint_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullSmallintNullableField() *UpdateFields {
+	var v null.Int64
+	f.SmallintNullableField = &v
+	return f
+}
+
+// SetSmallintUnsignedNullableField sets smallint_unsigned_nullable_field to be updated.
+func (f *UpdateFields) SetSmallintUnsignedNullableField(v null.Int64) *UpdateFields {
+	f.SmallintUnsignedNullableField = &v
+	return f
+}
+
+// SetNullSmallintUnsignedNullableField sets smallint_unsigned_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullSmallintUnsignedNullableField() *UpdateFields {
+	var v null.Int64
+	f.SmallintUnsignedNullableField = &v
+	return f
+}
+
+// SetMediumintField sets mediumint_field to be updated.
+func (f *UpdateFields) SetMediumintField(v int64) *UpdateFields {
+	f.MediumintField = &v
+	return f
+}
+
+// SetMediumintUnsignedField sets mediumint_unsigned_field to be updated.
+func (f *UpdateFields) SetMediumintUnsignedField(v int64) *UpdateFields {
+	f.MediumintUnsignedField = &v
+	return f
+}
+
+// SetMediumintNullableField sets mediumint_nullable_field to be updated.
+func (f *UpdateFields) SetMediumintNullableField(v null.Int64) *UpdateFields {
+	f.MediumintNullableField = &v
+	return f
+}
+
+// SetNullMediumintNullableField sets mediumint_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullMediumintNullableField() *UpdateFields {
+	var v null.Int64
+	f.MediumintNullableField = &v
+	return f
+}
+
+// SetMediumintUnsignedNullableField sets mediumint_unsigned_nullable_field to be updated.
+func (f *UpdateFields) SetMediumintUnsignedNullableField(v null.Int64) *UpdateFields {
+	f.MediumintUnsignedNullableField = &v
+	return f
+}
+
+// SetNullMediumintUnsignedNullableField sets mediumint_unsigned_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullMediumintUnsignedNullableField() *UpdateFields {
+	var v null.Int64
+	f.MediumintUnsignedNullableField = &v
+	return f
+}
+
+// SetIntField sets int_field to be updated.
+func (f *UpdateFields) SetIntField(v int64) *UpdateFields {
+	f.IntField = &v
+	return f
+}
+
+// SetIntUnsignedField sets int_unsigned_field to be updated.
+func (f *UpdateFields) SetIntUnsignedField(v int64) *UpdateFields {
+	f.IntUnsignedField = &v
+	return f
+}
+
+// SetIntNullableField sets int_nullable_field to be updated.
+func (f *UpdateFields) SetIntNullableField(v null.Int64) *UpdateFields {
+	f.IntNullableField = &v
+	return f
+}
+
+// SetNullIntNullableField sets int_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullIntNullableField() *UpdateFields {
+	var v null.Int64
+	f.IntNullableField = &v
+	return f
+}
+
+// SetIntUnsignedNullableField sets int_unsigned_nullable_field to be updated.
+func (f *UpdateFields) SetIntUnsignedNullableField(v null.Int64) *UpdateFields {
+	f.IntUnsignedNullableField = &v
+	return f
+}
+
+// SetNullIntUnsignedNullableField sets int_unsigned_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullIntUnsignedNullableField() *UpdateFields {
+	var v null.Int64
+	f.IntUnsignedNullableField = &v
+	return f
+}
+
+// SetBigintField sets bigint_field to be updated.
+func (f *UpdateFields) SetBigintField(v int64) *UpdateFields {
+	f.BigintField = &v
+	return f
+}
+
+// SetBigintUnsignedField sets bigint_unsigned_field to be updated.
+func (f *UpdateFields) SetBigintUnsignedField(v uint64) *UpdateFields {
+	f.BigintUnsignedField = &v
+	return f
+}
+
+// SetBigintNullableField sets bigint_nullable_field to be updated.
+func (f *UpdateFields) SetBigintNullableField(v null.Int64) *UpdateFields {
+	f.BigintNullableField = &v
+	return f
+}
+
+// SetNullBigintNullableField sets bigint_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullBigintNullableField() *UpdateFields {
+	var v null.Int64
+	f.BigintNullableField = &v
+	return f
+}
+
+// SetBigintUnsignedNullableField sets bigint_unsigned_nullable_field to be updated.
+func (f *UpdateFields) SetBigintUnsignedNullableField(v null.Uint64) *UpdateFields {
+	f.BigintUnsignedNullableField = &v
+	return f
+}
+
+// SetNullBigintUnsignedNullableField sets bigint_unsigned_nullable_field to be updated to NULL.
+func (f *UpdateFields) SetNullBigintUnsignedNullableField() *UpdateFields {
+	var v null.Uint64
+	f.BigintUnsignedNullableField = &v
+	return f
+}
+
+// SetFloatField sets float_field to be updated.
+func (f *UpdateFields) SetFloatField(v float32) *UpdateFields {
+	f.FloatField = &v
+	return f
+}
+
+// SetFloatNullField sets float_null_field to be updated.
+func (f *UpdateFields) SetFloatNullField(v null.Float32) *UpdateFields {
+	f.FloatNullField = &v
+	return f
+}
+
+// SetNullFloatNullField sets float_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullFloatNullField() *UpdateFields {
+	var v null.Float32
+	f.FloatNullField = &v
+	return f
+}
+
+// SetDoubleField sets double_field to be updated.
+func (f *UpdateFields) SetDoubleField(v float64) *UpdateFields {
+	f.DoubleField = &v
+	return f
+}
+
+// SetDoubleNullField sets double_null_field to be updated.
+func (f *UpdateFields) SetDoubleNullField(v null.Float64) *UpdateFields {
+	f.DoubleNullField = &v
+	return f
+}
+
+// SetNullDoubleNullField sets double_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullDoubleNullField() *UpdateFields {
+	var v null.Float64
+	f.DoubleNullField = &v
+	return f
+}
+
+// SetTinytextField sets tinytext_field to be updated.
+func (f *UpdateFields) SetTinytextField(v string) *UpdateFields {
+	f.TinytextField = &v
+	return f
+}
+
+// SetTinytextNullField sets tinytext_null_field to be updated.
+func (f *UpdateFields) SetTinytextNullField(v null.String) *UpdateFields {
+	f.TinytextNullField = &v
+	return f
+}
+
+// SetNullTinytextNullField sets tinytext_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullTinytextNullField() *UpdateFields {
+	var v null.String
+	f.TinytextNullField = &v
+	return f
+}
+
+// SetMediumtextField sets mediumtext_field to be updated.
+func (f *UpdateFields) SetMediumtextField(v string) *UpdateFields {
+	f.MediumtextField = &v
+	return f
+}
+
+// SetMediumtextNullField sets mediumtext_null_field to be updated.
+func (f *UpdateFields) SetMediumtextNullField(v null.String) *UpdateFields {
+	f.MediumtextNullField = &v
+	return f
+}
+
+// SetNullMediumtextNullField sets mediumtext_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullMediumtextNullField() *UpdateFields {
+	var v null.String
+	f.MediumtextNullField = &v
+	return f
+}
+
+// SetTextField sets text_field to be updated.
+func (f *UpdateFields) SetTextField(v string) *UpdateFields {
+	f.TextField = &v
+	return f
+}
+
+// SetTextNullField sets text_null_field to be updated.
+func (f *UpdateFields) SetTextNullField(v null.String) *UpdateFields {
+	f.TextNullField = &v
+	return f
+}
+
+// SetNullTextNullField sets text_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullTextNullField() *UpdateFields {
+	var v null.String
+	f.TextNullField = &v
+	return f
+}
+
+// SetLongtextField sets longtext_field to be updated.
+func (f *UpdateFields) SetLongtextField(v string) *UpdateFields {
+	f.LongtextField = &v
+	return f
+}
+
+// SetLongtextNullField sets longtext_null_field to be updated.
+func (f *UpdateFields) SetLongtextNullField(v null.String) *UpdateFields {
+	f.LongtextNullField = &v
+	return f
+}
+
+// SetNullLongtextNullField sets longtext_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullLongtextNullField() *UpdateFields {
+	var v null.String
+	f.LongtextNullField = &v
+	return f
+}
+
+// SetVarcharFiledField sets varchar_filed_field to be updated.
+func (f *UpdateFields) SetVarcharFiledField(v string) *UpdateFields {
+	f.VarcharFiledField = &v
+	return f
+}
+
+// SetVarcharNullField sets varchar_null_field to be updated.
+func (f *UpdateFields) SetVarcharNullField(v null.String) *UpdateFields {
+	f.VarcharNullField = &v
+	return f
+}
+
+// SetNullVarcharNullField sets varchar_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullVarcharNullField() *UpdateFields {
+	var v null.String
+	f.VarcharNullField = &v
+	return f
+}
+
+// SetCharFiledField sets char_filed_field to be updated.
+func (f *UpdateFields) SetCharFiledField(v string) *UpdateFields {
+	f.CharFiledField = &v
+	return f
+}
+
+// SetCharFiledNullField sets char_filed_null_field to be updated.
+func (f *UpdateFields) SetCharFiledNullField(v null.String) *UpdateFields {
+	f.CharFiledNullField = &v
+	return f
+}
+
+// SetNullCharFiledNullField sets char_filed_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullCharFiledNullField() *UpdateFields {
+	var v null.String
+	f.CharFiledNullField = &v
+	return f
+}
+
+// SetDateField sets date_field to be updated.
+func (f *UpdateFields) SetDateField(v time.Time) *UpdateFields {
+	f.DateField = &v
+	return f
+}
+
+// SetDateNullField sets date_null_field to be updated.
+func (f *UpdateFields) SetDateNullField(v null.Time) *UpdateFields {
+	f.DateNullField = &v
+	return f
+}
+
+// SetNullDateNullField sets date_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullDateNullField() *UpdateFields {
+	var v null.Time
+	f.DateNullField = &v
+	return f
+}
+
+// SetDatetimeField sets datetime_field to be updated.
+func (f *UpdateFields) SetDatetimeField(v time.Time) *UpdateFields {
+	f.DatetimeField = &v
+	return f
+}
+
+// SetDatetimeNullField sets datetime_null_field to be updated.
+func (f *UpdateFields) SetDatetimeNullField(v null.Time) *UpdateFields {
+	f.DatetimeNullField = &v
+	return f
+}
+
+// SetNullDatetimeNullField sets datetime_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullDatetimeNullField() *UpdateFields {
+	var v null.Time
+	f.DatetimeNullField = &v
+	return f
+}
+
+// SetTimeField sets time_field to be updated.
+func (f *UpdateFields) SetTimeField(v string) *UpdateFields {
+	f.TimeField = &v
+	return f
+}
+
+// SetTimeNullField sets time_null_field to be updated.
+func (f *UpdateFields) SetTimeNullField(v null.String) *UpdateFields {
+	f.TimeNullField = &v
+	return f
+}
+
+// SetNullTimeNullField sets time_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullTimeNullField() *UpdateFields {
+	var v null.String
+	f.TimeNullField = &v
+	return f
+}
+
+// SetTimestampField sets timestamp_field to be updated.
+func (f *UpdateFields) SetTimestampField(v time.Time) *UpdateFields {
+	f.TimestampField = &v
+	return f
+}
+
+// SetTimestampNullField sets timestamp_null_field to be updated.
+func (f *UpdateFields) SetTimestampNullField(v null.Time) *UpdateFields {
+	f.TimestampNullField = &v
+	return f
+}
+
+// SetNullTimestampNullField sets timestamp_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullTimestampNullField() *UpdateFields {
+	var v null.Time
+	f.TimestampNullField = &v
+	return f
+}
+
+// SetTinyblobField sets tinyblob_field to be updated.
+func (f *UpdateFields) SetTinyblobField(v []byte) *UpdateFields {
+	f.TinyblobField = &v
+	return f
+}
+
+// SetTinyblobNullField sets tinyblob_null_field to be updated.
+func (f *UpdateFields) SetTinyblobNullField(v null.Bytes) *UpdateFields {
+	f.TinyblobNullField = &v
+	return f
+}
+
+// SetNullTinyblobNullField sets tinyblob_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullTinyblobNullField() *UpdateFields {
+	var v null.Bytes
+	f.TinyblobNullField = &v
+	return f
+}
+
+// SetMediumblobField sets mediumblob_field to be updated.
+func (f *UpdateFields) SetMediumblobField(v []byte) *UpdateFields {
+	f.MediumblobField = &v
+	return f
+}
+
+// SetMediumblobNullField sets mediumblob_null_field to be updated.
+func (f *UpdateFields) SetMediumblobNullField(v null.Bytes) *UpdateFields {
+	f.MediumblobNullField = &v
+	return f
+}
+
+// SetNullMediumblobNullField sets mediumblob_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullMediumblobNullField() *UpdateFields {
+	var v null.Bytes
+	f.MediumblobNullField = &v
+	return f
+}
+
+// SetBlobField sets blob_field to be updated.
+func (f *UpdateFields) SetBlobField(v []byte) *UpdateFields {
+	f.BlobField = &v
+	return f
+}
+
+// SetBlobNullField sets blob_null_field to be updated.
+func (f *UpdateFields) SetBlobNullField(v null.Bytes) *UpdateFields {
+	f.BlobNullField = &v
+	return f
+}
+
+// SetNullBlobNullField sets blob_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullBlobNullField() *UpdateFields {
+	var v null.Bytes
+	f.BlobNullField = &v
+	return f
+}
+
+// SetLongblobField sets longblob_field to be updated.
+func (f *UpdateFields) SetLongblobField(v []byte) *UpdateFields {
+	f.LongblobField = &v
+	return f
+}
+
+// SetLongblobNullField sets longblob_null_field to be updated.
+func (f *UpdateFields) SetLongblobNullField(v null.Bytes) *UpdateFields {
+	f.LongblobNullField = &v
+	return f
+}
+
+// SetNullLongblobNullField sets longblob_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullLongblobNullField() *UpdateFields {
+	var v null.Bytes
+	f.LongblobNullField = &v
+	return f
+}
+
+// SetJsonField sets json_field to be updated.
+func (f *UpdateFields) SetJsonField(v json.RawMessage) *UpdateFields {
+	f.JsonField = &v
+	return f
+}
+
+// SetJsonNullField sets json_null_field to be updated.
+func (f *UpdateFields) SetJsonNullField(v null.JSON) *UpdateFields {
+	f.JsonNullField = &v
+	return f
+}
+
+// SetNullJsonNullField sets json_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullJsonNullField() *UpdateFields {
+	var v null.JSON
+	f.JsonNullField = &v
+	return f
+}
+
+// SetBoolField sets bool_field to be updated.
+func (f *UpdateFields) SetBoolField(v bool) *UpdateFields {
+	f.BoolField = &v
+	return f
+}
+
+// SetBoolNullField sets bool_null_field to be updated.
+func (f *UpdateFields) SetBoolNullField(v null.Bool) *UpdateFields {
+	f.BoolNullField = &v
+	return f
+}
+
+// SetNullBoolNullField sets bool_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullBoolNullField() *UpdateFields {
+	var v null.Bool
+	f.BoolNullField = &v
+	return f
+}
+
+// SetDecimalField sets decimal_field to be updated.
+func (f *UpdateFields) SetDecimalField(v decimal.Decimal) *UpdateFields {
+	f.DecimalField = &v
+	return f
+}
+
+// SetDecimalNullField sets decimal_null_field to be updated.
+func (f *UpdateFields) SetDecimalNullField(v null.Decimal) *UpdateFields {
+	f.DecimalNullField = &v
+	return f
+}
+
+// SetNullDecimalNullField sets decimal_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullDecimalNullField() *UpdateFields {
+	var v null.Decimal
+	f.DecimalNullField = &v
+	return f
+}
+
+// SetEnumField sets enum_field to be updated.
+func (f *UpdateFields) SetEnumField(v FieldsEnumFieldEnum) *UpdateFields {
+	f.EnumField = &v
+	return f
+}
+
+// SetEnumNullField sets enum_null_field to be updated.
+func (f *UpdateFields) SetEnumNullField(v null.Null[FieldsEnumNullFieldEnum]) *UpdateFields {
+	f.EnumNullField = &v
+	return f
+}
+
+// SetNullEnumNullField sets enum_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullEnumNullField() *UpdateFields {
+	var v null.Null[FieldsEnumNullFieldEnum]
+	f.EnumNullField = &v
+	return f
+}
+
+// SetSetField sets set_field to be updated.
+func (f *UpdateFields) SetSetField(v FieldsSetFieldSet) *UpdateFields {
+	f.SetField = &v
+	return f
+}
+
+// SetSetNullField sets set_null_field to be updated.
+func (f *UpdateFields) SetSetNullField(v null.Null[FieldsSetNullFieldSet]) *UpdateFields {
+	f.SetNullField = &v
+	return f
+}
+
+// SetNullSetNullField sets set_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullSetNullField() *UpdateFields {
+	var v null.Null[FieldsSetNullFieldSet]
+	f.SetNullField = &v
+	return f
+}
+
+// SetBitField sets bit_field to be updated.
+func (f *UpdateFields) SetBitField(v []byte) *UpdateFields {
+	f.BitField = &v
+	return f
+}
+
+// SetBitNullField sets bit_null_field to be updated.
+func (f *UpdateFields) SetBitNullField(v null.Bytes) *UpdateFields {
+	f.BitNullField = &v
+	return f
+}
+
+// SetNullBitNullField sets bit_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullBitNullField() *UpdateFields {
+	var v null.Bytes
+	f.BitNullField = &v
+	return f
+}
+
+// SetYearField sets year_field to be updated.
+func (f *UpdateFields) SetYearField(v int64) *UpdateFields {
+	f.YearField = &v
+	return f
+}
+
+// SetYearNullField sets year_null_field to be updated.
+func (f *UpdateFields) SetYearNullField(v null.Int64) *UpdateFields {
+	f.YearNullField = &v
+	return f
+}
+
+// SetNullYearNullField sets year_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullYearNullField() *UpdateFields {
+	var v null.Int64
+	f.YearNullField = &v
+	return f
+}
+
+// SetBinaryField sets binary_field to be updated.
+func (f *UpdateFields) SetBinaryField(v []byte) *UpdateFields {
+	f.BinaryField = &v
+	return f
+}
+
+// SetBinaryNullField sets binary_null_field to be updated.
+func (f *UpdateFields) SetBinaryNullField(v null.Bytes) *UpdateFields {
+	f.BinaryNullField = &v
+	return f
+}
+
+// SetNullBinaryNullField sets binary_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullBinaryNullField() *UpdateFields {
+	var v null.Bytes
+	f.BinaryNullField = &v
+	return f
+}
+
+// SetVarbinaryField sets varbinary_field to be updated.
+func (f *UpdateFields) SetVarbinaryField(v []byte) *UpdateFields {
+	f.VarbinaryField = &v
+	return f
+}
+
+// SetVarbinaryNullField sets varbinary_null_field to be updated.
+func (f *UpdateFields) SetVarbinaryNullField(v null.Bytes) *UpdateFields {
+	f.VarbinaryNullField = &v
+	return f
+}
+
+// SetNullVarbinaryNullField sets varbinary_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullVarbinaryNullField() *UpdateFields {
+	var v null.Bytes
+	f.VarbinaryNullField = &v
+	return f
+}
+
+// SetGeometryNullField sets geometry_null_field to be updated.
+func (f *UpdateFields) SetGeometryNullField(v null.Bytes) *UpdateFields {
+	f.GeometryNullField = &v
+	return f
+}
+
+// SetNullGeometryNullField sets geometry_null_field to be updated to NULL.
+func (f *UpdateFields) SetNullGeometryNullField() *UpdateFields {
+	var v null.Bytes
+	f.GeometryNullField = &v
+	return f
+}
+
+// DiffUpdate returns UpdateFields with the columns changed from f to after, or nil if nothing is changed.
+// Columns not in UpdateFields are not compared.
+func (f *Fields) DiffUpdate(after *Fields) *UpdateFields {
+	update := &UpdateFields{}
+	changed := false
+	if !(f.TinyintField == after.TinyintField) {
+		update.SetTinyintField(after.TinyintField)
+		changed = true
+	}
+	if !(f.TinyintUnsignedField == after.TinyintUnsignedField) {
+		update.SetTinyintUnsignedField(after.TinyintUnsignedField)
+		changed = true
+	}
+	if !(f.TinyintNullableField.Valid == after.TinyintNullableField.Valid && (!f.TinyintNullableField.Valid || f.TinyintNullableField.V == after.TinyintNullableField.V)) {
+		update.SetTinyintNullableField(after.TinyintNullableField)
+		changed = true
+	}
+	if !(f.TinyintUnsignedNullableField.Valid == after.TinyintUnsignedNullableField.Valid && (!f.TinyintUnsignedNullableField.Valid || f.TinyintUnsignedNullableField.V == after.TinyintUnsignedNullableField.V)) {
+		update.SetTinyintUnsignedNullableField(after.TinyintUnsignedNullableField)
+		changed = true
+	}
+	if !(f.SmallintField == after.SmallintField) {
+		update.SetSmallintField(after.SmallintField)
+		changed = true
+	}
+	if !(f.SmallintUnsignedField == after.SmallintUnsignedField) {
+		update.SetSmallintUnsignedField(after.SmallintUnsignedField)
+		changed = true
+	}
+	if !(f.SmallintNullableField.Valid == after.SmallintNullableField.Valid && (!f.SmallintNullableField.Valid || f.SmallintNullableField.V == after.SmallintNullableField.V)) {
+		update.SetSmallintNullableField(after.SmallintNullableField)
+		changed = true
+	}
+	if !(f.SmallintUnsignedNullableField.Valid == after.SmallintUnsignedNullableField.Valid && (!f.SmallintUnsignedNullableField.Valid || f.SmallintUnsignedNullableField.V == after.SmallintUnsignedNullableField.V)) {
+		update.SetSmallintUnsignedNullableField(after.SmallintUnsignedNullableField)
+		changed = true
+	}
+	if !(f.MediumintField == after.MediumintField) {
+		update.SetMediumintField(after.MediumintField)
+		changed = true
+	}
+	if !(f.MediumintUnsignedField == after.MediumintUnsignedField) {
+		update.SetMediumintUnsignedField(after.MediumintUnsignedField)
+		changed = true
+	}
+	if !(f.MediumintNullableField.Valid == after.MediumintNullableField.Valid && (!f.MediumintNullableField.Valid || f.MediumintNullableField.V == after.MediumintNullableField.V)) {
+		update.SetMediumintNullableField(after.MediumintNullableField)
+		changed = true
+	}
+	if !(f.MediumintUnsignedNullableField.Valid == after.MediumintUnsignedNullableField.Valid && (!f.MediumintUnsignedNullableField.Valid || f.MediumintUnsignedNullableField.V == after.MediumintUnsignedNullableField.V)) {
+		update.SetMediumintUnsignedNullableField(after.MediumintUnsignedNullableField)
+		changed = true
+	}
+	if !(f.IntField == after.IntField) {
+		update.SetIntField(after.IntField)
+		changed = true
+	}
+	if !(f.IntUnsignedField == after.IntUnsignedField) {
+		update.SetIntUnsignedField(after.IntUnsignedField)
+		changed = true
+	}
+	if !(f.IntNullableField.Valid == after.IntNullableField.Valid && (!f.IntNullableField.Valid || f.IntNullableField.V == after.IntNullableField.V)) {
+		update.SetIntNullableField(after.IntNullableField)
+		changed = true
+	}
+	if !(f.IntUnsignedNullableField.Valid == after.IntUnsignedNullableField.Valid && (!f.IntUnsignedNullableField.Valid || f.IntUnsignedNullableField.V == after.IntUnsignedNullableField.V)) {
+		update.SetIntUnsignedNullableField(after.IntUnsignedNullableField)
+		changed = true
+	}
+	if !(f.BigintField == after.BigintField) {
+		update.SetBigintField(after.BigintField)
+		changed = true
+	}
+	if !(f.BigintUnsignedField == after.BigintUnsignedField) {
+		update.SetBigintUnsignedField(after.BigintUnsignedField)
+		changed = true
+	}
+	if !(f.BigintNullableField.Valid == after.BigintNullableField.Valid && (!f.BigintNullableField.Valid || f.BigintNullableField.V == after.BigintNullableField.V)) {
+		update.SetBigintNullableField(after.BigintNullableField)
+		changed = true
+	}
+	if !(f.BigintUnsignedNullableField.Valid == after.BigintUnsignedNullableField.Valid && (!f.BigintUnsignedNullableField.Valid || f.BigintUnsignedNullableField.V == after.BigintUnsignedNullableField.V)) {
+		update.SetBigintUnsignedNullableField(after.BigintUnsignedNullableField)
+		changed = true
+	}
+	if !(f.FloatField == after.FloatField) {
+		update.SetFloatField(after.FloatField)
+		changed = true
+	}
+	if !(f.FloatNullField.Valid == after.FloatNullField.Valid && (!f.FloatNullField.Valid || f.FloatNullField.V == after.FloatNullField.V)) {
+		update.SetFloatNullField(after.FloatNullField)
+		changed = true
+	}
+	if !(f.DoubleField == after.DoubleField) {
+		update.SetDoubleField(after.DoubleField)
+		changed = true
+	}
+	if !(f.DoubleNullField.Valid == after.DoubleNullField.Valid && (!f.DoubleNullField.Valid || f.DoubleNullField.V == after.DoubleNullField.V)) {
+		update.SetDoubleNullField(after.DoubleNullField)
+		changed = true
+	}
+	if !(f.TinytextField == after.TinytextField) {
+		update.SetTinytextField(after.TinytextField)
+		changed = true
+	}
+	if !(f.TinytextNullField.Valid == after.TinytextNullField.Valid && (!f.TinytextNullField.Valid || f.TinytextNullField.V == after.TinytextNullField.V)) {
+		update.SetTinytextNullField(after.TinytextNullField)
+		changed = true
+	}
+	if !(f.MediumtextField == after.MediumtextField) {
+		update.SetMediumtextField(after.MediumtextField)
+		changed = true
+	}
+	if !(f.MediumtextNullField.Valid == after.MediumtextNullField.Valid && (!f.MediumtextNullField.Valid || f.MediumtextNullField.V == after.MediumtextNullField.V)) {
+		update.SetMediumtextNullField(after.MediumtextNullField)
+		changed = true
+	}
+	if !(f.TextField == after.TextField) {
+		update.SetTextField(after.TextField)
+		changed = true
+	}
+	if !(f.TextNullField.Valid == after.TextNullField.Valid && (!f.TextNullField.Valid || f.TextNullField.V == after.TextNullField.V)) {
+		update.SetTextNullField(after.TextNullField)
+		changed = true
+	}
+	if !(f.LongtextField == after.LongtextField) {
+		update.SetLongtextField(after.LongtextField)
+		changed = true
+	}
+	if !(f.LongtextNullField.Valid == after.LongtextNullField.Valid && (!f.LongtextNullField.Valid || f.LongtextNullField.V == after.LongtextNullField.V)) {
+		update.SetLongtextNullField(after.LongtextNullField)
+		changed = true
+	}
+	if !(f.VarcharFiledField == after.VarcharFiledField) {
+		update.SetVarcharFiledField(after.VarcharFiledField)
+		changed = true
+	}
+	if !(f.VarcharNullField.Valid == after.VarcharNullField.Valid && (!f.VarcharNullField.Valid || f.VarcharNullField.V == after.VarcharNullField.V)) {
+		update.SetVarcharNullField(after.VarcharNullField)
+		changed = true
+	}
+	if !(f.CharFiledField == after.CharFiledField) {
+		update.SetCharFiledField(after.CharFiledField)
+		changed = true
+	}
+	if !(f.CharFiledNullField.Valid == after.CharFiledNullField.Valid && (!f.CharFiledNullField.Valid || f.CharFiledNullField.V == after.CharFiledNullField.V)) {
+		update.SetCharFiledNullField(after.CharFiledNullField)
+		changed = true
+	}
+	if !(f.DateField.Equal(after.DateField)) {
+		update.SetDateField(after.DateField)
+		changed = true
+	}
+	if !(f.DateNullField.Valid == after.DateNullField.Valid && (!f.DateNullField.Valid || f.DateNullField.V.Equal(after.DateNullField.V))) {
+		update.SetDateNullField(after.DateNullField)
+		changed = true
+	}
+	if !(f.DatetimeField.Equal(after.DatetimeField)) {
+		update.SetDatetimeField(after.DatetimeField)
+		changed = true
+	}
+	if !(f.DatetimeNullField.Valid == after.DatetimeNullField.Valid && (!f.DatetimeNullField.Valid || f.DatetimeNullField.V.Equal(after.DatetimeNullField.V))) {
+		update.SetDatetimeNullField(after.DatetimeNullField)
+		changed = true
+	}
+	if !(f.TimeField == after.TimeField) {
+		update.SetTimeField(after.TimeField)
+		changed = true
+	}
+	if !(f.TimeNullField.Valid == after.TimeNullField.Valid && (!f.TimeNullField.Valid || f.TimeNullField.V == after.TimeNullField.V)) {
+		update.SetTimeNullField(after.TimeNullField)
+		changed = true
+	}
+	if !(f.TimestampField.Equal(after.TimestampField)) {
+		update.SetTimestampField(after.TimestampField)
+		changed = true
+	}
+	if !(f.TimestampNullField.Valid == after.TimestampNullField.Valid && (!f.TimestampNullField.Valid || f.TimestampNullField.V.Equal(after.TimestampNullField.V))) {
+		update.SetTimestampNullField(after.TimestampNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.TinyblobField, after.TinyblobField)) {
+		update.SetTinyblobField(after.TinyblobField)
+		changed = true
+	}
+	if !(f.TinyblobNullField.Valid == after.TinyblobNullField.Valid && (!f.TinyblobNullField.Valid || bytes.Equal(f.TinyblobNullField.V, after.TinyblobNullField.V))) {
+		update.SetTinyblobNullField(after.TinyblobNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.MediumblobField, after.MediumblobField)) {
+		update.SetMediumblobField(after.MediumblobField)
+		changed = true
+	}
+	if !(f.MediumblobNullField.Valid == after.MediumblobNullField.Valid && (!f.MediumblobNullField.Valid || bytes.Equal(f.MediumblobNullField.V, after.MediumblobNullField.V))) {
+		update.SetMediumblobNullField(after.MediumblobNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.BlobField, after.BlobField)) {
+		update.SetBlobField(after.BlobField)
+		changed = true
+	}
+	if !(f.BlobNullField.Valid == after.BlobNullField.Valid && (!f.BlobNullField.Valid || bytes.Equal(f.BlobNullField.V, after.BlobNullField.V))) {
+		update.SetBlobNullField(after.BlobNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.LongblobField, after.LongblobField)) {
+		update.SetLongblobField(after.LongblobField)
+		changed = true
+	}
+	if !(f.LongblobNullField.Valid == after.LongblobNullField.Valid && (!f.LongblobNullField.Valid || bytes.Equal(f.LongblobNullField.V, after.LongblobNullField.V))) {
+		update.SetLongblobNullField(after.LongblobNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.JsonField, after.JsonField)) {
+		update.SetJsonField(after.JsonField)
+		changed = true
+	}
+	if !(f.JsonNullField.Valid == after.JsonNullField.Valid && (!f.JsonNullField.Valid || bytes.Equal(f.JsonNullField.V, after.JsonNullField.V))) {
+		update.SetJsonNullField(after.JsonNullField)
+		changed = true
+	}
+	if !(f.BoolField == after.BoolField) {
+		update.SetBoolField(after.BoolField)
+		changed = true
+	}
+	if !(f.BoolNullField.Valid == after.BoolNullField.Valid && (!f.BoolNullField.Valid || f.BoolNullField.V == after.BoolNullField.V)) {
+		update.SetBoolNullField(after.BoolNullField)
+		changed = true
+	}
+	if !(f.DecimalField.Equal(after.DecimalField)) {
+		update.SetDecimalField(after.DecimalField)
+		changed = true
+	}
+	if !(f.DecimalNullField.Valid == after.DecimalNullField.Valid && (!f.DecimalNullField.Valid || f.DecimalNullField.V.Equal(after.DecimalNullField.V))) {
+		update.SetDecimalNullField(after.DecimalNullField)
+		changed = true
+	}
+	if !(f.EnumField == after.EnumField) {
+		update.SetEnumField(after.EnumField)
+		changed = true
+	}
+	if !(f.EnumNullField.Valid == after.EnumNullField.Valid && (!f.EnumNullField.Valid || f.EnumNullField.V == after.EnumNullField.V)) {
+		update.SetEnumNullField(after.EnumNullField)
+		changed = true
+	}
+	if !(reflect.DeepEqual(f.SetField, after.SetField)) {
+		update.SetSetField(after.SetField)
+		changed = true
+	}
+	if !(f.SetNullField.Valid == after.SetNullField.Valid && (!f.SetNullField.Valid || reflect.DeepEqual(f.SetNullField.V, after.SetNullField.V))) {
+		update.SetSetNullField(after.SetNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.BitField, after.BitField)) {
+		update.SetBitField(after.BitField)
+		changed = true
+	}
+	if !(f.BitNullField.Valid == after.BitNullField.Valid && (!f.BitNullField.Valid || bytes.Equal(f.BitNullField.V, after.BitNullField.V))) {
+		update.SetBitNullField(after.BitNullField)
+		changed = true
+	}
+	if !(f.YearField == after.YearField) {
+		update.SetYearField(after.YearField)
+		changed = true
+	}
+	if !(f.YearNullField.Valid == after.YearNullField.Valid && (!f.YearNullField.Valid || f.YearNullField.V == after.YearNullField.V)) {
+		update.SetYearNullField(after.YearNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.BinaryField, after.BinaryField)) {
+		update.SetBinaryField(after.BinaryField)
+		changed = true
+	}
+	if !(f.BinaryNullField.Valid == after.BinaryNullField.Valid && (!f.BinaryNullField.Valid || bytes.Equal(f.BinaryNullField.V, after.BinaryNullField.V))) {
+		update.SetBinaryNullField(after.BinaryNullField)
+		changed = true
+	}
+	if !(bytes.Equal(f.VarbinaryField, after.VarbinaryField)) {
+		update.SetVarbinaryField(after.VarbinaryField)
+		changed = true
+	}
+	if !(f.VarbinaryNullField.Valid == after.VarbinaryNullField.Valid && (!f.VarbinaryNullField.Valid || bytes.Equal(f.VarbinaryNullField.V, after.VarbinaryNullField.V))) {
+		update.SetVarbinaryNullField(after.VarbinaryNullField)
+		changed = true
+	}
+	if !(f.GeometryNullField.Valid == after.GeometryNullField.Valid && (!f.GeometryNullField.Valid || bytes.Equal(f.GeometryNullField.V, after.GeometryNullField.V))) {
+		update.SetGeometryNullField(after.GeometryNullField)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return update
+}
+
+func (f *Fields) TableMetadata() *meta.Table {
+	return FieldsMetadata
+}
+
 const FieldsTableName = "fields"
+
+// Column names of Fields.
+const (
+	FieldsColumnId                             = "id"
+	FieldsColumnTinyintField                   = "tinyint_field"
+	FieldsColumnTinyintUnsignedField           = "tinyint_unsigned_field"
+	FieldsColumnTinyintNullableField           = "tinyint_nullable_field"
+	FieldsColumnTinyintUnsignedNullableField   = "tinyint_unsigned_nullable_field"
+	FieldsColumnSmallintField                  = "smallint_field"
+	FieldsColumnSmallintUnsignedField          = "smallint_unsigned_field"
+	FieldsColumnSmallintNullableField          = "smallint_nullable_field"
+	FieldsColumnSmallintUnsignedNullableField  = "smallint_unsigned_nullable_field"
+	FieldsColumnMediumintField                 = "mediumint_field"
+	FieldsColumnMediumintUnsignedField         = "mediumint_unsigned_field"
+	FieldsColumnMediumintNullableField         = "mediumint_nullable_field"
+	FieldsColumnMediumintUnsignedNullableField = "mediumint_unsigned_nullable_field"
+	FieldsColumnIntField                       = "int_field"
+	FieldsColumnIntUnsignedField               = "int_unsigned_field"
+	FieldsColumnIntNullableField               = "int_nullable_field"
+	FieldsColumnIntUnsignedNullableField       = "int_unsigned_nullable_field"
+	FieldsColumnBigintField                    = "bigint_field"
+	FieldsColumnBigintUnsignedField            = "bigint_unsigned_field"
+	FieldsColumnBigintNullableField            = "bigint_nullable_field"
+	FieldsColumnBigintUnsignedNullableField    = "bigint_unsigned_nullable_field"
+	FieldsColumnFloatField                     = "float_field"
+	FieldsColumnFloatNullField                 = "float_null_field"
+	FieldsColumnDoubleField                    = "double_field"
+	FieldsColumnDoubleNullField                = "double_null_field"
+	FieldsColumnTinytextField                  = "tinytext_field"
+	FieldsColumnTinytextNullField              = "tinytext_null_field"
+	FieldsColumnMediumtextField                = "mediumtext_field"
+	FieldsColumnMediumtextNullField            = "mediumtext_null_field"
+	FieldsColumnTextField                      = "text_field"
+	FieldsColumnTextNullField                  = "text_null_field"
+	FieldsColumnLongtextField                  = "longtext_field"
+	FieldsColumnLongtextNullField              = "longtext_null_field"
+	FieldsColumnVarcharFiledField              = "varchar_filed_field"
+	FieldsColumnVarcharNullField               = "varchar_null_field"
+	FieldsColumnCharFiledField                 = "char_filed_field"
+	FieldsColumnCharFiledNullField             = "char_filed_null_field"
+	FieldsColumnDateField                      = "date_field"
+	FieldsColumnDateNullField                  = "date_null_field"
+	FieldsColumnDatetimeField                  = "datetime_field"
+	FieldsColumnDatetimeNullField              = "datetime_null_field"
+	FieldsColumnTimeField                      = "time_field"
+	FieldsColumnTimeNullField                  = "time_null_field"
+	FieldsColumnTimestampField                 = "timestamp_field"
+	FieldsColumnTimestampNullField             = "timestamp_null_field"
+	FieldsColumnTinyblobField                  = "tinyblob_field"
+	FieldsColumnTinyblobNullField              = "tinyblob_null_field"
+	FieldsColumnMediumblobField                = "mediumblob_field"
+	FieldsColumnMediumblobNullField            = "mediumblob_null_field"
+	FieldsColumnBlobField                      = "blob_field"
+	FieldsColumnBlobNullField                  = "blob_null_field"
+	FieldsColumnLongblobField                  = "longblob_field"
+	FieldsColumnLongblobNullField              = "longblob_null_field"
+	FieldsColumnJsonField                      = "json_field"
+	FieldsColumnJsonNullField                  = "json_null_field"
+	FieldsColumnBoolField                      = "bool_field"
+	FieldsColumnBoolNullField                  = "bool_null_field"
+	FieldsColumnDecimalField                   = "decimal_field"
+	FieldsColumnDecimalNullField               = "decimal_null_field"
+	FieldsColumnEnumField                      = "enum_field"
+	FieldsColumnEnumNullField                  = "enum_null_field"
+	FieldsColumnSetField                       = "set_field"
+	FieldsColumnSetNullField                   = "set_null_field"
+	FieldsColumnBitField                       = "bit_field"
+	FieldsColumnBitNullField                   = "bit_null_field"
+	FieldsColumnYearField                      = "year_field"
+	FieldsColumnYearNullField                  = "year_null_field"
+	FieldsColumnBinaryField                    = "binary_field"
+	FieldsColumnBinaryNullField                = "binary_null_field"
+	FieldsColumnVarbinaryField                 = "varbinary_field"
+	FieldsColumnVarbinaryNullField             = "varbinary_null_field"
+	FieldsColumnGeometryNullField              = "geometry_null_field"
+)
+
+// FieldsColumnNames are the column names of Fields in the table order.
+var FieldsColumnNames = []string{
+	FieldsColumnId,
+	FieldsColumnTinyintField,
+	FieldsColumnTinyintUnsignedField,
+	FieldsColumnTinyintNullableField,
+	FieldsColumnTinyintUnsignedNullableField,
+	FieldsColumnSmallintField,
+	FieldsColumnSmallintUnsignedField,
+	FieldsColumnSmallintNullableField,
+	FieldsColumnSmallintUnsignedNullableField,
+	FieldsColumnMediumintField,
+	FieldsColumnMediumintUnsignedField,
+	FieldsColumnMediumintNullableField,
+	FieldsColumnMediumintUnsignedNullableField,
+	FieldsColumnIntField,
+	FieldsColumnIntUnsignedField,
+	FieldsColumnIntNullableField,
+	FieldsColumnIntUnsignedNullableField,
+	FieldsColumnBigintField,
+	FieldsColumnBigintUnsignedField,
+	FieldsColumnBigintNullableField,
+	FieldsColumnBigintUnsignedNullableField,
+	FieldsColumnFloatField,
+	FieldsColumnFloatNullField,
+	FieldsColumnDoubleField,
+	FieldsColumnDoubleNullField,
+	FieldsColumnTinytextField,
+	FieldsColumnTinytextNullField,
+	FieldsColumnMediumtextField,
+	FieldsColumnMediumtextNullField,
+	FieldsColumnTextField,
+	FieldsColumnTextNullField,
+	FieldsColumnLongtextField,
+	FieldsColumnLongtextNullField,
+	FieldsColumnVarcharFiledField,
+	FieldsColumnVarcharNullField,
+	FieldsColumnCharFiledField,
+	FieldsColumnCharFiledNullField,
+	FieldsColumnDateField,
+	FieldsColumnDateNullField,
+	FieldsColumnDatetimeField,
+	FieldsColumnDatetimeNullField,
+	FieldsColumnTimeField,
+	FieldsColumnTimeNullField,
+	FieldsColumnTimestampField,
+	FieldsColumnTimestampNullField,
+	FieldsColumnTinyblobField,
+	FieldsColumnTinyblobNullField,
+	FieldsColumnMediumblobField,
+	FieldsColumnMediumblobNullField,
+	FieldsColumnBlobField,
+	FieldsColumnBlobNullField,
+	FieldsColumnLongblobField,
+	FieldsColumnLongblobNullField,
+	FieldsColumnJsonField,
+	FieldsColumnJsonNullField,
+	FieldsColumnBoolField,
+	FieldsColumnBoolNullField,
+	FieldsColumnDecimalField,
+	FieldsColumnDecimalNullField,
+	FieldsColumnEnumField,
+	FieldsColumnEnumNullField,
+	FieldsColumnSetField,
+	FieldsColumnSetNullField,
+	FieldsColumnBitField,
+	FieldsColumnBitNullField,
+	FieldsColumnYearField,
+	FieldsColumnYearNullField,
+	FieldsColumnBinaryField,
+	FieldsColumnBinaryNullField,
+	FieldsColumnVarbinaryField,
+	FieldsColumnVarbinaryNullField,
+	FieldsColumnGeometryNullField,
+}
+
+// FieldsPrimaryKeyColumnNames are the column names of the primary key of Fields in the key order.
+var FieldsPrimaryKeyColumnNames = []string{
+	FieldsColumnId,
+}
+
+// FieldsColumns are the typed handles of the columns of Fields for building conditions.
+var FieldsColumns = struct {
+	Id                             query.Column[int64]
+	TinyintField                   query.Column[int64]
+	TinyintUnsignedField           query.Column[int64]
+	TinyintNullableField           query.Column[null.Int64]
+	TinyintUnsignedNullableField   query.Column[null.Int64]
+	SmallintField                  query.Column[int64]
+	SmallintUnsignedField          query.Column[int64]
+	SmallintNullableField          query.Column[null.Int64]
+	SmallintUnsignedNullableField  query.Column[null.Int64]
+	MediumintField                 query.Column[int64]
+	MediumintUnsignedField         query.Column[int64]
+	MediumintNullableField         query.Column[null.Int64]
+	MediumintUnsignedNullableField query.Column[null.Int64]
+	IntField                       query.Column[int64]
+	IntUnsignedField               query.Column[int64]
+	IntNullableField               query.Column[null.Int64]
+	IntUnsignedNullableField       query.Column[null.Int64]
+	BigintField                    query.Column[int64]
+	BigintUnsignedField            query.Column[uint64]
+	BigintNullableField            query.Column[null.Int64]
+	BigintUnsignedNullableField    query.Column[null.Uint64]
+	FloatField                     query.Column[float32]
+	FloatNullField                 query.Column[null.Float32]
+	DoubleField                    query.Column[float64]
+	DoubleNullField                query.Column[null.Float64]
+	TinytextField                  query.Column[string]
+	TinytextNullField              query.Column[null.String]
+	MediumtextField                query.Column[string]
+	MediumtextNullField            query.Column[null.String]
+	TextField                      query.Column[string]
+	TextNullField                  query.Column[null.String]
+	LongtextField                  query.Column[string]
+	LongtextNullField              query.Column[null.String]
+	VarcharFiledField              query.Column[string]
+	VarcharNullField               query.Column[null.String]
+	CharFiledField                 query.Column[string]
+	CharFiledNullField             query.Column[null.String]
+	DateField                      query.Column[time.Time]
+	DateNullField                  query.Column[null.Time]
+	DatetimeField                  query.Column[time.Time]
+	DatetimeNullField              query.Column[null.Time]
+	TimeField                      query.Column[string]
+	TimeNullField                  query.Column[null.String]
+	TimestampField                 query.Column[time.Time]
+	TimestampNullField             query.Column[null.Time]
+	TinyblobField                  query.Column[[]byte]
+	TinyblobNullField              query.Column[null.Bytes]
+	MediumblobField                query.Column[[]byte]
+	MediumblobNullField            query.Column[null.Bytes]
+	BlobField                      query.Column[[]byte]
+	BlobNullField                  query.Column[null.Bytes]
+	LongblobField                  query.Column[[]byte]
+	LongblobNullField              query.Column[null.Bytes]
+	JsonField                      query.Column[json.RawMessage]
+	JsonNullField                  query.Column[null.JSON]
+	BoolField                      query.Column[bool]
+	BoolNullField                  query.Column[null.Bool]
+	DecimalField                   query.Column[decimal.Decimal]
+	DecimalNullField               query.Column[null.Decimal]
+	EnumField                      query.Column[FieldsEnumFieldEnum]
+	EnumNullField                  query.Column[null.Null[FieldsEnumNullFieldEnum]]
+	SetField                       query.Column[FieldsSetFieldSet]
+	SetNullField                   query.Column[null.Null[FieldsSetNullFieldSet]]
+	BitField                       query.Column[[]byte]
+	BitNullField                   query.Column[null.Bytes]
+	YearField                      query.Column[int64]
+	YearNullField                  query.Column[null.Int64]
+	BinaryField                    query.Column[[]byte]
+	BinaryNullField                query.Column[null.Bytes]
+	VarbinaryField                 query.Column[[]byte]
+	VarbinaryNullField             query.Column[null.Bytes]
+	GeometryNullField              query.Column[null.Bytes]
+}{
+	Id:                             query.NewColumn[int64](FieldsTableName, FieldsColumnId),
+	TinyintField:                   query.NewColumn[int64](FieldsTableName, FieldsColumnTinyintField),
+	TinyintUnsignedField:           query.NewColumn[int64](FieldsTableName, FieldsColumnTinyintUnsignedField),
+	TinyintNullableField:           query.NewColumn[null.Int64](FieldsTableName, FieldsColumnTinyintNullableField),
+	TinyintUnsignedNullableField:   query.NewColumn[null.Int64](FieldsTableName, FieldsColumnTinyintUnsignedNullableField),
+	SmallintField:                  query.NewColumn[int64](FieldsTableName, FieldsColumnSmallintField),
+	SmallintUnsignedField:          query.NewColumn[int64](FieldsTableName, FieldsColumnSmallintUnsignedField),
+	SmallintNullableField:          query.NewColumn[null.Int64](FieldsTableName, FieldsColumnSmallintNullableField),
+	SmallintUnsignedNullableField:  query.NewColumn[null.Int64](FieldsTableName, FieldsColumnSmallintUnsignedNullableField),
+	MediumintField:                 query.NewColumn[int64](FieldsTableName, FieldsColumnMediumintField),
+	MediumintUnsignedField:         query.NewColumn[int64](FieldsTableName, FieldsColumnMediumintUnsignedField),
+	MediumintNullableField:         query.NewColumn[null.Int64](FieldsTableName, FieldsColumnMediumintNullableField),
+	MediumintUnsignedNullableField: query.NewColumn[null.Int64](FieldsTableName, FieldsColumnMediumintUnsignedNullableField),
+	IntField:                       query.NewColumn[int64](FieldsTableName, FieldsColumnIntField),
+	IntUnsignedField:               query.NewColumn[int64](FieldsTableName, FieldsColumnIntUnsignedField),
+	IntNullableField:               query.NewColumn[null.Int64](FieldsTableName, FieldsColumnIntNullableField),
+	IntUnsignedNullableField:       query.NewColumn[null.Int64](FieldsTableName, FieldsColumnIntUnsignedNullableField),
+	BigintField:                    query.NewColumn[int64](FieldsTableName, FieldsColumnBigintField),
+	BigintUnsignedField:            query.NewColumn[uint64](FieldsTableName, FieldsColumnBigintUnsignedField),
+	BigintNullableField:            query.NewColumn[null.Int64](FieldsTableName, FieldsColumnBigintNullableField),
+	BigintUnsignedNullableField:    query.NewColumn[null.Uint64](FieldsTableName, FieldsColumnBigintUnsignedNullableField),
+	FloatField:                     query.NewColumn[float32](FieldsTableName, FieldsColumnFloatField),
+	FloatNullField:                 query.NewColumn[null.Float32](FieldsTableName, FieldsColumnFloatNullField),
+	DoubleField:                    query.NewColumn[float64](FieldsTableName, FieldsColumnDoubleField),
+	DoubleNullField:                query.NewColumn[null.Float64](FieldsTableName, FieldsColumnDoubleNullField),
+	TinytextField:                  query.NewColumn[string](FieldsTableName, FieldsColumnTinytextField),
+	TinytextNullField:              query.NewColumn[null.String](FieldsTableName, FieldsColumnTinytextNullField),
+	MediumtextField:                query.NewColumn[string](FieldsTableName, FieldsColumnMediumtextField),
+	MediumtextNullField:            query.NewColumn[null.String](FieldsTableName, FieldsColumnMediumtextNullField),
+	TextField:                      query.NewColumn[string](FieldsTableName, FieldsColumnTextField),
+	TextNullField:                  query.NewColumn[null.String](FieldsTableName, FieldsColumnTextNullField),
+	LongtextField:                  query.NewColumn[string](FieldsTableName, FieldsColumnLongtextField),
+	LongtextNullField:              query.NewColumn[null.String](FieldsTableName, FieldsColumnLongtextNullField),
+	VarcharFiledField:              query.NewColumn[string](FieldsTableName, FieldsColumnVarcharFiledField),
+	VarcharNullField:               query.NewColumn[null.String](FieldsTableName, FieldsColumnVarcharNullField),
+	CharFiledField:                 query.NewColumn[string](FieldsTableName, FieldsColumnCharFiledField),
+	CharFiledNullField:             query.NewColumn[null.String](FieldsTableName, FieldsColumnCharFiledNullField),
+	DateField:                      query.NewColumn[time.Time](FieldsTableName, FieldsColumnDateField),
+	DateNullField:                  query.NewColumn[null.Time](FieldsTableName, FieldsColumnDateNullField),
+	DatetimeField:                  query.NewColumn[time.Time](FieldsTableName, FieldsColumnDatetimeField),
+	DatetimeNullField:              query.NewColumn[null.Time](FieldsTableName, FieldsColumnDatetimeNullField),
+	TimeField:                      query.NewColumn[string](FieldsTableName, FieldsColumnTimeField),
+	TimeNullField:                  query.NewColumn[null.String](FieldsTableName, FieldsColumnTimeNullField),
+	TimestampField:                 query.NewColumn[time.Time](FieldsTableName, FieldsColumnTimestampField),
+	TimestampNullField:             query.NewColumn[null.Time](FieldsTableName, FieldsColumnTimestampNullField),
+	TinyblobField:                  query.NewColumn[[]byte](FieldsTableName, FieldsColumnTinyblobField),
+	TinyblobNullField:              query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnTinyblobNullField),
+	MediumblobField:                query.NewColumn[[]byte](FieldsTableName, FieldsColumnMediumblobField),
+	MediumblobNullField:            query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnMediumblobNullField),
+	BlobField:                      query.NewColumn[[]byte](FieldsTableName, FieldsColumnBlobField),
+	BlobNullField:                  query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnBlobNullField),
+	LongblobField:                  query.NewColumn[[]byte](FieldsTableName, FieldsColumnLongblobField),
+	LongblobNullField:              query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnLongblobNullField),
+	JsonField:                      query.NewColumn[json.RawMessage](FieldsTableName, FieldsColumnJsonField),
+	JsonNullField:                  query.NewColumn[null.JSON](FieldsTableName, FieldsColumnJsonNullField),
+	BoolField:                      query.NewColumn[bool](FieldsTableName, FieldsColumnBoolField),
+	BoolNullField:                  query.NewColumn[null.Bool](FieldsTableName, FieldsColumnBoolNullField),
+	DecimalField:                   query.NewColumn[decimal.Decimal](FieldsTableName, FieldsColumnDecimalField),
+	DecimalNullField:               query.NewColumn[null.Decimal](FieldsTableName, FieldsColumnDecimalNullField),
+	EnumField:                      query.NewColumn[FieldsEnumFieldEnum](FieldsTableName, FieldsColumnEnumField),
+	EnumNullField:                  query.NewColumn[null.Null[FieldsEnumNullFieldEnum]](FieldsTableName, FieldsColumnEnumNullField),
+	SetField:                       query.NewColumn[FieldsSetFieldSet](FieldsTableName, FieldsColumnSetField),
+	SetNullField:                   query.NewColumn[null.Null[FieldsSetNullFieldSet]](FieldsTableName, FieldsColumnSetNullField),
+	BitField:                       query.NewColumn[[]byte](FieldsTableName, FieldsColumnBitField),
+	BitNullField:                   query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnBitNullField),
+	YearField:                      query.NewColumn[int64](FieldsTableName, FieldsColumnYearField),
+	YearNullField:                  query.NewColumn[null.Int64](FieldsTableName, FieldsColumnYearNullField),
+	BinaryField:                    query.NewColumn[[]byte](FieldsTableName, FieldsColumnBinaryField),
+	BinaryNullField:                query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnBinaryNullField),
+	VarbinaryField:                 query.NewColumn[[]byte](FieldsTableName, FieldsColumnVarbinaryField),
+	VarbinaryNullField:             query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnVarbinaryNullField),
+	GeometryNullField:              query.NewColumn[null.Bytes](FieldsTableName, FieldsColumnGeometryNullField),
+}
+
+// FieldsMetadata is the static metadata of Fields.
+var FieldsMetadata = &meta.Table{
+	Name:          FieldsTableName,
+	Columns:       FieldsColumnNames,
+	PrimaryKey:    FieldsPrimaryKeyColumnNames,
+	AutoIncrement: FieldsColumnId,
+}
+
+// FieldsKey is the primary key of Fields in the key order.
+type FieldsKey struct {
+	Id int64
+}
+
+// FieldsPrimaryKey is the handle of the primary key of Fields for building conditions.
+var FieldsPrimaryKey = query.NewKey(FieldsTableName, FieldsColumnId)
+
+// PrimaryKey returns the primary key of the model.
+func (f *Fields) PrimaryKey() FieldsKey {
+	return FieldsKey{
+		Id: f.Id,
+	}
+}
+
+// Cond returns the condition matching the record of the key.
+func (k FieldsKey) Cond() query.Condition {
+	return FieldsPrimaryKey.Eq(k.Id)
+}
+
+// FieldsKeysIn returns the condition matching the records of the keys.
+// It results in an error if keys is empty.
+func FieldsKeysIn(keys ...FieldsKey) query.Condition {
+	tuples := make([][]any, len(keys))
+	for i, k := range keys {
+		tuples[i] = []any{k.Id}
+	}
+	return FieldsPrimaryKey.In(tuples...)
+}
+
+// FindFieldsByKeys finds Fields by the primary keys. It returns an empty slice if not found.
+func FindFieldsByKeys(ctx context.Context, finder iface.Finder, keys ...FieldsKey) ([]*Fields, error) {
+	dest := []*Fields{}
+	if len(keys) == 0 {
+		return dest, nil
+	}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(FieldsTableName), FieldsKeysIn(keys...),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// FindFieldsById finds Fields by the primary key.
+// It returns exql.ErrRecordNotFound if not found.
+func FindFieldsById(ctx context.Context, finder iface.Finder, id int64) (*Fields, error) {
+	var dest Fields
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(FieldsTableName), FieldsColumns.Id.Eq(id),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
+
+// FindFieldsByVarcharNullField finds Fields by the unique key.
+// It returns exql.ErrRecordNotFound if not found.
+func FindFieldsByVarcharNullField(ctx context.Context, finder iface.Finder, varcharNullField null.String) (*Fields, error) {
+	var dest Fields
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(FieldsTableName), FieldsColumns.VarcharNullField.Eq(varcharNullField),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
+
+// FieldsEnumFieldEnum is the type of Fields.EnumField.
+type FieldsEnumFieldEnum string
+
+const (
+	FieldsEnumFieldEnumA FieldsEnumFieldEnum = "a"
+	FieldsEnumFieldEnumB FieldsEnumFieldEnum = "b"
+)
+
+// Valid reports whether v is one of the defined values.
+func (v FieldsEnumFieldEnum) Valid() bool {
+	switch v {
+	case FieldsEnumFieldEnumA, FieldsEnumFieldEnumB:
+		return true
+	}
+	return false
+}
+
+// Scan implements sql.Scanner. It rejects unknown values.
+func (v *FieldsEnumFieldEnum) Scan(src any) error {
+	s, err := scanFieldsEnumFieldEnum(src)
+	if err != nil {
+		return err
+	}
+	if !FieldsEnumFieldEnum(s).Valid() {
+		return fmt.Errorf("invalid value for FieldsEnumFieldEnum: %q", s)
+	}
+	*v = FieldsEnumFieldEnum(s)
+	return nil
+}
+
+// Value implements driver.Valuer. It rejects unknown values.
+func (v FieldsEnumFieldEnum) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, fmt.Errorf("invalid value for FieldsEnumFieldEnum: %q", string(v))
+	}
+	return string(v), nil
+}
+
+func scanFieldsEnumFieldEnum(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	}
+	return "", fmt.Errorf("unsupported type for FieldsEnumFieldEnum: %T", src)
+}
+
+// FieldsEnumNullFieldEnum is the type of Fields.EnumNullField.
+type FieldsEnumNullFieldEnum string
+
+const (
+	FieldsEnumNullFieldEnumA FieldsEnumNullFieldEnum = "a"
+	FieldsEnumNullFieldEnumB FieldsEnumNullFieldEnum = "b"
+)
+
+// Valid reports whether v is one of the defined values.
+func (v FieldsEnumNullFieldEnum) Valid() bool {
+	switch v {
+	case FieldsEnumNullFieldEnumA, FieldsEnumNullFieldEnumB:
+		return true
+	}
+	return false
+}
+
+// Scan implements sql.Scanner. It rejects unknown values.
+func (v *FieldsEnumNullFieldEnum) Scan(src any) error {
+	s, err := scanFieldsEnumNullFieldEnum(src)
+	if err != nil {
+		return err
+	}
+	if !FieldsEnumNullFieldEnum(s).Valid() {
+		return fmt.Errorf("invalid value for FieldsEnumNullFieldEnum: %q", s)
+	}
+	*v = FieldsEnumNullFieldEnum(s)
+	return nil
+}
+
+// Value implements driver.Valuer. It rejects unknown values.
+func (v FieldsEnumNullFieldEnum) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, fmt.Errorf("invalid value for FieldsEnumNullFieldEnum: %q", string(v))
+	}
+	return string(v), nil
+}
+
+func scanFieldsEnumNullFieldEnum(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	}
+	return "", fmt.Errorf("unsupported type for FieldsEnumNullFieldEnum: %T", src)
+}
+
+// FieldsSetFieldSet is the type of Fields.SetField, a set of FieldsSetFieldSetValue.
+type FieldsSetFieldSet []FieldsSetFieldSetValue
+
+// FieldsSetFieldSetValue is a value of FieldsSetFieldSet.
+type FieldsSetFieldSetValue string
+
+const (
+	FieldsSetFieldSetA FieldsSetFieldSetValue = "a"
+	FieldsSetFieldSetB FieldsSetFieldSetValue = "b"
+)
+
+// Valid reports whether v is one of the defined values.
+func (v FieldsSetFieldSetValue) Valid() bool {
+	switch v {
+	case FieldsSetFieldSetA, FieldsSetFieldSetB:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether all values of v are defined.
+func (v FieldsSetFieldSet) Valid() bool {
+	for _, e := range v {
+		if !e.Valid() {
+			return false
+		}
+	}
+	return true
+}
+
+// Scan implements sql.Scanner. It rejects unknown values.
+func (v *FieldsSetFieldSet) Scan(src any) error {
+	s, err := scanFieldsSetFieldSet(src)
+	if err != nil {
+		return err
+	}
+	set := FieldsSetFieldSet{}
+	if s != "" {
+		for _, e := range strings.Split(s, ",") {
+			set = append(set, FieldsSetFieldSetValue(e))
+		}
+	}
+	if !set.Valid() {
+		return fmt.Errorf("invalid value for FieldsSetFieldSet: %q", s)
+	}
+	*v = set
+	return nil
+}
+
+// Value implements driver.Valuer. It rejects unknown values.
+func (v FieldsSetFieldSet) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, fmt.Errorf("invalid value for FieldsSetFieldSet: %q", v)
+	}
+	list := make([]string, len(v))
+	for i, e := range v {
+		list[i] = string(e)
+	}
+	return strings.Join(list, ","), nil
+}
+
+func scanFieldsSetFieldSet(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	}
+	return "", fmt.Errorf("unsupported type for FieldsSetFieldSet: %T", src)
+}
+
+// FieldsSetNullFieldSet is the type of Fields.SetNullField, a set of FieldsSetNullFieldSetValue.
+type FieldsSetNullFieldSet []FieldsSetNullFieldSetValue
+
+// FieldsSetNullFieldSetValue is a value of FieldsSetNullFieldSet.
+type FieldsSetNullFieldSetValue string
+
+const (
+	FieldsSetNullFieldSetA FieldsSetNullFieldSetValue = "a"
+	FieldsSetNullFieldSetB FieldsSetNullFieldSetValue = "b"
+)
+
+// Valid reports whether v is one of the defined values.
+func (v FieldsSetNullFieldSetValue) Valid() bool {
+	switch v {
+	case FieldsSetNullFieldSetA, FieldsSetNullFieldSetB:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether all values of v are defined.
+func (v FieldsSetNullFieldSet) Valid() bool {
+	for _, e := range v {
+		if !e.Valid() {
+			return false
+		}
+	}
+	return true
+}
+
+// Scan implements sql.Scanner. It rejects unknown values.
+func (v *FieldsSetNullFieldSet) Scan(src any) error {
+	s, err := scanFieldsSetNullFieldSet(src)
+	if err != nil {
+		return err
+	}
+	set := FieldsSetNullFieldSet{}
+	if s != "" {
+		for _, e := range strings.Split(s, ",") {
+			set = append(set, FieldsSetNullFieldSetValue(e))
+		}
+	}
+	if !set.Valid() {
+		return fmt.Errorf("invalid value for FieldsSetNullFieldSet: %q", s)
+	}
+	*v = set
+	return nil
+}
+
+// Value implements driver.Valuer. It rejects unknown values.
+func (v FieldsSetNullFieldSet) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, fmt.Errorf("invalid value for FieldsSetNullFieldSet: %q", v)
+	}
+	list := make([]string, len(v))
+	for i, e := range v {
+		list[i] = string(e)
+	}
+	return strings.Join(list, ","), nil
+}
+
+func scanFieldsSetNullFieldSet(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	}
+	return "", fmt.Errorf("unsupported type for FieldsSetNullFieldSet: %T", src)
+}
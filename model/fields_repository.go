@@ -0,0 +1,379 @@
+// Code generated by exql. DO NOT EDIT.
+package model
+
+import "context"
+import "fmt"
+import "reflect"
+import "sync"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/null"
+
+//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -source=$GOFILE -destination=mock_$GOPACKAGE/$GOFILE -package=mock_$GOPACKAGE
+
+// FieldsRepository is the repository of Fields.
+// NewFieldsRepository returns the implementation by exql, and FakeFieldsRepository is
+// the in-memory implementation for tests. Mocks by gomock are generated by go generate.
+type FieldsRepository interface {
+	// Insert inserts the model and sets Id by auto_increment.
+	Insert(ctx context.Context, record *Fields) error
+	// Update updates the columns set in update of the model by the primary key.
+	Update(ctx context.Context, id int64, update *UpdateFields) error
+	// Delete deletes the model by the primary key.
+	Delete(ctx context.Context, id int64) error
+	// FindById finds the model by the primary key. It returns exql.ErrRecordNotFound if not found.
+	FindById(ctx context.Context, id int64) (*Fields, error)
+	// FindByVarcharNullField finds the model by the unique key. It returns exql.ErrRecordNotFound if not found.
+	FindByVarcharNullField(ctx context.Context, varcharNullField null.String) (*Fields, error)
+}
+
+type fieldsRepository struct {
+	db iface.SaverFinder
+}
+
+// NewFieldsRepository returns FieldsRepository by exql.DB or exql.Tx.
+func NewFieldsRepository(db iface.SaverFinder) FieldsRepository {
+	return &fieldsRepository{db: db}
+}
+
+func (r *fieldsRepository) Insert(ctx context.Context, record *Fields) error {
+	_, err := r.db.InsertContext(ctx, record)
+	return err
+}
+
+func (r *fieldsRepository) Update(ctx context.Context, id int64, update *UpdateFields) error {
+	_, err := r.db.UpdateModelContext(ctx, update, FieldsKey{Id: id}.Cond())
+	return err
+}
+
+func (r *fieldsRepository) Delete(ctx context.Context, id int64) error {
+	_, err := r.db.DeleteContext(ctx, FieldsTableName, FieldsKey{Id: id}.Cond())
+	return err
+}
+
+func (r *fieldsRepository) FindById(ctx context.Context, id int64) (*Fields, error) {
+	return FindFieldsById(ctx, r.db, id)
+}
+
+func (r *fieldsRepository) FindByVarcharNullField(ctx context.Context, varcharNullField null.String) (*Fields, error) {
+	return FindFieldsByVarcharNullField(ctx, r.db, varcharNullField)
+}
+
+// FakeFieldsRepository is the in-memory FieldsRepository for tests.
+// It stores copies of models, and is safe for concurrent use.
+type FakeFieldsRepository struct {
+	mu           sync.Mutex
+	records      []*Fields
+	lastInsertId int64
+}
+
+var _ FieldsRepository = (*FakeFieldsRepository)(nil)
+
+// NewFakeFieldsRepository returns the fake repository with the records inserted.
+// It panics if records can't be inserted.
+func NewFakeFieldsRepository(records ...*Fields) *FakeFieldsRepository {
+	r := &FakeFieldsRepository{}
+	for _, record := range records {
+		if err := r.Insert(context.Background(), record); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Records returns copies of the stored models in the inserted order.
+func (r *FakeFieldsRepository) Records() []*Fields {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]*Fields, len(r.records))
+	for i, record := range r.records {
+		c := *record
+		ret[i] = &c
+	}
+	return ret
+}
+
+func (r *FakeFieldsRepository) Insert(ctx context.Context, record *Fields) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := record.Id
+	if id == 0 {
+		id = r.lastInsertId + 1
+	}
+	// Check duplicates before any change, so that rejected records leave no state
+	if r.indexOf(id) >= 0 {
+		return fmt.Errorf("duplicate primary key of Fields")
+	}
+	record.Id = id
+	if id > r.lastInsertId {
+		r.lastInsertId = id
+	}
+	c := *record
+	r.records = append(r.records, &c)
+	return nil
+}
+
+func (r *FakeFieldsRepository) Update(ctx context.Context, id int64, update *UpdateFields) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i := r.indexOf(id)
+	if i < 0 {
+		return nil
+	}
+	record := r.records[i]
+	if update.TinyintField != nil {
+		record.TinyintField = *update.TinyintField
+	}
+	if update.TinyintUnsignedField != nil {
+		record.TinyintUnsignedField = *update.TinyintUnsignedField
+	}
+	if update.TinyintNullableField != nil {
+		record.TinyintNullableField = *update.TinyintNullableField
+	}
+	if update.TinyintUnsignedNullableField != nil {
+		record.TinyintUnsignedNullableField = *update.TinyintUnsignedNullableField
+	}
+	if update.SmallintField != nil {
+		record.SmallintField = *update.SmallintField
+	}
+	if update.SmallintUnsignedField != nil {
+		record.SmallintUnsignedField = *update.SmallintUnsignedField
+	}
+	if update.SmallintNullableField != nil {
+		record.SmallintNullableField = *update.SmallintNullableField
+	}
+	if update.SmallintUnsignedNullableField != nil {
+		record.SmallintUnsignedNullableField = *update.SmallintUnsignedNullableField
+	}
+	if update.MediumintField != nil {
+		record.MediumintField = *update.MediumintField
+	}
+	if update.MediumintUnsignedField != nil {
+		record.MediumintUnsignedField = *update.MediumintUnsignedField
+	}
+	if update.MediumintNullableField != nil {
+		record.MediumintNullableField = *update.MediumintNullableField
+	}
+	if update.MediumintUnsignedNullableField != nil {
+		record.MediumintUnsignedNullableField = *update.MediumintUnsignedNullableField
+	}
+	if update.IntField != nil {
+		record.IntField = *update.IntField
+	}
+	if update.IntUnsignedField != nil {
+		record.IntUnsignedField = *update.IntUnsignedField
+	}
+	if update.IntNullableField != nil {
+		record.IntNullableField = *update.IntNullableField
+	}
+	if update.IntUnsignedNullableField != nil {
+		record.IntUnsignedNullableField = *update.IntUnsignedNullableField
+	}
+	if update.BigintField != nil {
+		record.BigintField = *update.BigintField
+	}
+	if update.BigintUnsignedField != nil {
+		record.BigintUnsignedField = *update.BigintUnsignedField
+	}
+	if update.BigintNullableField != nil {
+		record.BigintNullableField = *update.BigintNullableField
+	}
+	if update.BigintUnsignedNullableField != nil {
+		record.BigintUnsignedNullableField = *update.BigintUnsignedNullableField
+	}
+	if update.FloatField != nil {
+		record.FloatField = *update.FloatField
+	}
+	if update.FloatNullField != nil {
+		record.FloatNullField = *update.FloatNullField
+	}
+	if update.DoubleField != nil {
+		record.DoubleField = *update.DoubleField
+	}
+	if update.DoubleNullField != nil {
+		record.DoubleNullField = *update.DoubleNullField
+	}
+	if update.TinytextField != nil {
+		record.TinytextField = *update.TinytextField
+	}
+	if update.TinytextNullField != nil {
+		record.TinytextNullField = *update.TinytextNullField
+	}
+	if update.MediumtextField != nil {
+		record.MediumtextField = *update.MediumtextField
+	}
+	if update.MediumtextNullField != nil {
+		record.MediumtextNullField = *update.MediumtextNullField
+	}
+	if update.TextField != nil {
+		record.TextField = *update.TextField
+	}
+	if update.TextNullField != nil {
+		record.TextNullField = *update.TextNullField
+	}
+	if update.LongtextField != nil {
+		record.LongtextField = *update.LongtextField
+	}
+	if update.LongtextNullField != nil {
+		record.LongtextNullField = *update.LongtextNullField
+	}
+	if update.VarcharFiledField != nil {
+		record.VarcharFiledField = *update.VarcharFiledField
+	}
+	if update.VarcharNullField != nil {
+		record.VarcharNullField = *update.VarcharNullField
+	}
+	if update.CharFiledField != nil {
+		record.CharFiledField = *update.CharFiledField
+	}
+	if update.CharFiledNullField != nil {
+		record.CharFiledNullField = *update.CharFiledNullField
+	}
+	if update.DateField != nil {
+		record.DateField = *update.DateField
+	}
+	if update.DateNullField != nil {
+		record.DateNullField = *update.DateNullField
+	}
+	if update.DatetimeField != nil {
+		record.DatetimeField = *update.DatetimeField
+	}
+	if update.DatetimeNullField != nil {
+		record.DatetimeNullField = *update.DatetimeNullField
+	}
+	if update.TimeField != nil {
+		record.TimeField = *update.TimeField
+	}
+	if update.TimeNullField != nil {
+		record.TimeNullField = *update.TimeNullField
+	}
+	if update.TimestampField != nil {
+		record.TimestampField = *update.TimestampField
+	}
+	if update.TimestampNullField != nil {
+		record.TimestampNullField = *update.TimestampNullField
+	}
+	if update.TinyblobField != nil {
+		record.TinyblobField = *update.TinyblobField
+	}
+	if update.TinyblobNullField != nil {
+		record.TinyblobNullField = *update.TinyblobNullField
+	}
+	if update.MediumblobField != nil {
+		record.MediumblobField = *update.MediumblobField
+	}
+	if update.MediumblobNullField != nil {
+		record.MediumblobNullField = *update.MediumblobNullField
+	}
+	if update.BlobField != nil {
+		record.BlobField = *update.BlobField
+	}
+	if update.BlobNullField != nil {
+		record.BlobNullField = *update.BlobNullField
+	}
+	if update.LongblobField != nil {
+		record.LongblobField = *update.LongblobField
+	}
+	if update.LongblobNullField != nil {
+		record.LongblobNullField = *update.LongblobNullField
+	}
+	if update.JsonField != nil {
+		record.JsonField = *update.JsonField
+	}
+	if update.JsonNullField != nil {
+		record.JsonNullField = *update.JsonNullField
+	}
+	if update.BoolField != nil {
+		record.BoolField = *update.BoolField
+	}
+	if update.BoolNullField != nil {
+		record.BoolNullField = *update.BoolNullField
+	}
+	if update.DecimalField != nil {
+		record.DecimalField = *update.DecimalField
+	}
+	if update.DecimalNullField != nil {
+		record.DecimalNullField = *update.DecimalNullField
+	}
+	if update.EnumField != nil {
+		record.EnumField = *update.EnumField
+	}
+	if update.EnumNullField != nil {
+		record.EnumNullField = *update.EnumNullField
+	}
+	if update.SetField != nil {
+		record.SetField = *update.SetField
+	}
+	if update.SetNullField != nil {
+		record.SetNullField = *update.SetNullField
+	}
+	if update.BitField != nil {
+		record.BitField = *update.BitField
+	}
+	if update.BitNullField != nil {
+		record.BitNullField = *update.BitNullField
+	}
+	if update.YearField != nil {
+		record.YearField = *update.YearField
+	}
+	if update.YearNullField != nil {
+		record.YearNullField = *update.YearNullField
+	}
+	if update.BinaryField != nil {
+		record.BinaryField = *update.BinaryField
+	}
+	if update.BinaryNullField != nil {
+		record.BinaryNullField = *update.BinaryNullField
+	}
+	if update.VarbinaryField != nil {
+		record.VarbinaryField = *update.VarbinaryField
+	}
+	if update.VarbinaryNullField != nil {
+		record.VarbinaryNullField = *update.VarbinaryNullField
+	}
+	if update.GeometryNullField != nil {
+		record.GeometryNullField = *update.GeometryNullField
+	}
+	return nil
+}
+
+func (r *FakeFieldsRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if i := r.indexOf(id); i >= 0 {
+		r.records = append(r.records[:i], r.records[i+1:]...)
+	}
+	return nil
+}
+
+func (r *FakeFieldsRepository) indexOf(id int64) int {
+	for i, record := range r.records {
+		if reflect.DeepEqual(record.Id, id) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *FakeFieldsRepository) FindById(ctx context.Context, id int64) (*Fields, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, record := range r.records {
+		if reflect.DeepEqual(record.Id, id) {
+			c := *record
+			return &c, nil
+		}
+	}
+	return nil, iface.ErrRecordNotFound{}
+}
+
+func (r *FakeFieldsRepository) FindByVarcharNullField(ctx context.Context, varcharNullField null.String) (*Fields, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, record := range r.records {
+		if varcharNullField.Valid && reflect.DeepEqual(record.VarcharNullField, varcharNullField) {
+			c := *record
+			return &c, nil
+		}
+	}
+	return nil, iface.ErrRecordNotFound{}
+}
@@ -1,6 +1,11 @@
 // Code generated by exql. DO NOT EDIT.
 package model
 
+import "context"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/meta"
+import "github.com/loilo-inc/exql/v3/query"
+
 type GroupUsers struct {
 	Id      int64 `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
 	UserId  int64 `exql:"column:user_id;type:int;not null" json:"user_id"`
@@ -12,7 +17,6 @@ func (g *GroupUsers) TableName() string {
 }
 
 type UpdateGroupUsers struct {
-	Id      *int64 `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
 	UserId  *int64 `exql:"column:user_id;type:int;not null" json:"user_id"`
 	GroupId *int64 `exql:"column:group_id;type:int;not null" json:"group_id"`
 }
@@ -21,4 +25,207 @@ func (g *UpdateGroupUsers) UpdateTableName() string {
 	return GroupUsersTableName
 }
 
+// NewUpdateGroupUsers returns the empty UpdateGroupUsers to set columns to be updated by setters.
+func NewUpdateGroupUsers() *UpdateGroupUsers {
+	return &UpdateGroupUsers{}
+}
+
+// SetUserId sets user_id to be updated.
+func (g *UpdateGroupUsers) SetUserId(v int64) *UpdateGroupUsers {
+	g.UserId = &v
+	return g
+}
+
+// SetGroupId sets group_id to be updated.
+func (g *UpdateGroupUsers) SetGroupId(v int64) *UpdateGroupUsers {
+	g.GroupId = &v
+	return g
+}
+
+// DiffUpdate returns UpdateGroupUsers with the columns changed from g to after, or nil if nothing is changed.
+// Columns not in UpdateGroupUsers are not compared.
+func (g *GroupUsers) DiffUpdate(after *GroupUsers) *UpdateGroupUsers {
+	update := &UpdateGroupUsers{}
+	changed := false
+	if !(g.UserId == after.UserId) {
+		update.SetUserId(after.UserId)
+		changed = true
+	}
+	if !(g.GroupId == after.GroupId) {
+		update.SetGroupId(after.GroupId)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return update
+}
+
+func (g *GroupUsers) TableMetadata() *meta.Table {
+	return GroupUsersMetadata
+}
+
 const GroupUsersTableName = "group_users"
+
+// Column names of GroupUsers.
+const (
+	GroupUsersColumnId      = "id"
+	GroupUsersColumnUserId  = "user_id"
+	GroupUsersColumnGroupId = "group_id"
+)
+
+// GroupUsersColumnNames are the column names of GroupUsers in the table order.
+var GroupUsersColumnNames = []string{
+	GroupUsersColumnId,
+	GroupUsersColumnUserId,
+	GroupUsersColumnGroupId,
+}
+
+// GroupUsersPrimaryKeyColumnNames are the column names of the primary key of GroupUsers in the key order.
+var GroupUsersPrimaryKeyColumnNames = []string{
+	GroupUsersColumnId,
+}
+
+// GroupUsersColumns are the typed handles of the columns of GroupUsers for building conditions.
+var GroupUsersColumns = struct {
+	Id      query.Column[int64]
+	UserId  query.Column[int64]
+	GroupId query.Column[int64]
+}{
+	Id:      query.NewColumn[int64](GroupUsersTableName, GroupUsersColumnId),
+	UserId:  query.NewColumn[int64](GroupUsersTableName, GroupUsersColumnUserId),
+	GroupId: query.NewColumn[int64](GroupUsersTableName, GroupUsersColumnGroupId),
+}
+
+// GroupUsersMetadata is the static metadata of GroupUsers.
+var GroupUsersMetadata = &meta.Table{
+	Name:          GroupUsersTableName,
+	Columns:       GroupUsersColumnNames,
+	PrimaryKey:    GroupUsersPrimaryKeyColumnNames,
+	AutoIncrement: GroupUsersColumnId,
+}
+
+// GroupUsersRelations are the relations of GroupUsers by foreign keys, to be passed to exql.Preload.
+var GroupUsersRelations = struct {
+	User  *meta.Relation
+	Group *meta.Relation
+}{
+	User: &meta.Relation{
+		Kind:       meta.BelongsTo,
+		Table:      UsersTableName,
+		LocalKey:   GroupUsersColumnUserId,
+		ForeignKey: UsersColumnId,
+	},
+	Group: &meta.Relation{
+		Kind:       meta.BelongsTo,
+		Table:      UserGroupsTableName,
+		LocalKey:   GroupUsersColumnGroupId,
+		ForeignKey: UserGroupsColumnId,
+	},
+}
+
+// LoadUser loads Users whose Id matches UserId.
+func (g *GroupUsers) LoadUser(ctx context.Context, finder iface.Finder) (*Users, error) {
+	var dest Users
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :? = ?",
+		query.Cols(UsersTableName), query.Cols(UsersColumnId), g.UserId,
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
+
+// LoadGroup loads UserGroups whose Id matches GroupId.
+func (g *GroupUsers) LoadGroup(ctx context.Context, finder iface.Finder) (*UserGroups, error) {
+	var dest UserGroups
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :? = ?",
+		query.Cols(UserGroupsTableName), query.Cols(UserGroupsColumnId), g.GroupId,
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
+
+// GroupUsersKey is the primary key of GroupUsers in the key order.
+type GroupUsersKey struct {
+	Id int64
+}
+
+// GroupUsersPrimaryKey is the handle of the primary key of GroupUsers for building conditions.
+var GroupUsersPrimaryKey = query.NewKey(GroupUsersTableName, GroupUsersColumnId)
+
+// PrimaryKey returns the primary key of the model.
+func (g *GroupUsers) PrimaryKey() GroupUsersKey {
+	return GroupUsersKey{
+		Id: g.Id,
+	}
+}
+
+// Cond returns the condition matching the record of the key.
+func (k GroupUsersKey) Cond() query.Condition {
+	return GroupUsersPrimaryKey.Eq(k.Id)
+}
+
+// GroupUsersKeysIn returns the condition matching the records of the keys.
+// It results in an error if keys is empty.
+func GroupUsersKeysIn(keys ...GroupUsersKey) query.Condition {
+	tuples := make([][]any, len(keys))
+	for i, k := range keys {
+		tuples[i] = []any{k.Id}
+	}
+	return GroupUsersPrimaryKey.In(tuples...)
+}
+
+// FindGroupUsersByKeys finds GroupUsers by the primary keys. It returns an empty slice if not found.
+func FindGroupUsersByKeys(ctx context.Context, finder iface.Finder, keys ...GroupUsersKey) ([]*GroupUsers, error) {
+	dest := []*GroupUsers{}
+	if len(keys) == 0 {
+		return dest, nil
+	}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(GroupUsersTableName), GroupUsersKeysIn(keys...),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// FindGroupUsersById finds GroupUsers by the primary key.
+// It returns exql.ErrRecordNotFound if not found.
+func FindGroupUsersById(ctx context.Context, finder iface.Finder, id int64) (*GroupUsers, error) {
+	var dest GroupUsers
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(GroupUsersTableName), GroupUsersColumns.Id.Eq(id),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
+
+// FindGroupUsersByUserId finds GroupUsers by the index. It returns an empty slice if not found.
+func FindGroupUsersByUserId(ctx context.Context, finder iface.Finder, userId int64) ([]*GroupUsers, error) {
+	var dest []*GroupUsers
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(GroupUsersTableName), GroupUsersColumns.UserId.Eq(userId),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// FindGroupUsersByGroupId finds GroupUsers by the index. It returns an empty slice if not found.
+func FindGroupUsersByGroupId(ctx context.Context, finder iface.Finder, groupId int64) ([]*GroupUsers, error) {
+	var dest []*GroupUsers
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(GroupUsersTableName), GroupUsersColumns.GroupId.Eq(groupId),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
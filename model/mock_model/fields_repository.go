@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fields_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fields_repository.go -destination=mock_model/fields_repository.go -package=mock_model
+//
+
+// Package mock_model is a generated GoMock package.
+package mock_model
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/loilo-inc/exql/v3/model"
+	null "github.com/loilo-inc/exql/v3/null"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFieldsRepository is a mock of FieldsRepository interface.
+type MockFieldsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFieldsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFieldsRepositoryMockRecorder is the mock recorder for MockFieldsRepository.
+type MockFieldsRepositoryMockRecorder struct {
+	mock *MockFieldsRepository
+}
+
+// NewMockFieldsRepository creates a new mock instance.
+func NewMockFieldsRepository(ctrl *gomock.Controller) *MockFieldsRepository {
+	mock := &MockFieldsRepository{ctrl: ctrl}
+	mock.recorder = &MockFieldsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFieldsRepository) EXPECT() *MockFieldsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFieldsRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFieldsRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFieldsRepository)(nil).Delete), ctx, id)
+}
+
+// FindById mocks base method.
+func (m *MockFieldsRepository) FindById(ctx context.Context, id int64) (*model.Fields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*model.Fields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockFieldsRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockFieldsRepository)(nil).FindById), ctx, id)
+}
+
+// FindByVarcharNullField mocks base method.
+func (m *MockFieldsRepository) FindByVarcharNullField(ctx context.Context, varcharNullField null.String) (*model.Fields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByVarcharNullField", ctx, varcharNullField)
+	ret0, _ := ret[0].(*model.Fields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByVarcharNullField indicates an expected call of FindByVarcharNullField.
+func (mr *MockFieldsRepositoryMockRecorder) FindByVarcharNullField(ctx, varcharNullField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByVarcharNullField", reflect.TypeOf((*MockFieldsRepository)(nil).FindByVarcharNullField), ctx, varcharNullField)
+}
+
+// Insert mocks base method.
+func (m *MockFieldsRepository) Insert(ctx context.Context, record *model.Fields) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockFieldsRepositoryMockRecorder) Insert(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockFieldsRepository)(nil).Insert), ctx, record)
+}
+
+// Update mocks base method.
+func (m *MockFieldsRepository) Update(ctx context.Context, id int64, update *model.UpdateFields) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFieldsRepositoryMockRecorder) Update(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFieldsRepository)(nil).Update), ctx, id, update)
+}
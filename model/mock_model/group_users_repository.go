@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_users_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=group_users_repository.go -destination=mock_model/group_users_repository.go -package=mock_model
+//
+
+// Package mock_model is a generated GoMock package.
+package mock_model
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/loilo-inc/exql/v3/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupUsersRepository is a mock of GroupUsersRepository interface.
+type MockGroupUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupUsersRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupUsersRepositoryMockRecorder is the mock recorder for MockGroupUsersRepository.
+type MockGroupUsersRepositoryMockRecorder struct {
+	mock *MockGroupUsersRepository
+}
+
+// NewMockGroupUsersRepository creates a new mock instance.
+func NewMockGroupUsersRepository(ctrl *gomock.Controller) *MockGroupUsersRepository {
+	mock := &MockGroupUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockGroupUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupUsersRepository) EXPECT() *MockGroupUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockGroupUsersRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGroupUsersRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGroupUsersRepository)(nil).Delete), ctx, id)
+}
+
+// FindByGroupId mocks base method.
+func (m *MockGroupUsersRepository) FindByGroupId(ctx context.Context, groupId int64) ([]*model.GroupUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByGroupId", ctx, groupId)
+	ret0, _ := ret[0].([]*model.GroupUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByGroupId indicates an expected call of FindByGroupId.
+func (mr *MockGroupUsersRepositoryMockRecorder) FindByGroupId(ctx, groupId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByGroupId", reflect.TypeOf((*MockGroupUsersRepository)(nil).FindByGroupId), ctx, groupId)
+}
+
+// FindById mocks base method.
+func (m *MockGroupUsersRepository) FindById(ctx context.Context, id int64) (*model.GroupUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*model.GroupUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockGroupUsersRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockGroupUsersRepository)(nil).FindById), ctx, id)
+}
+
+// FindByUserId mocks base method.
+func (m *MockGroupUsersRepository) FindByUserId(ctx context.Context, userId int64) ([]*model.GroupUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, userId)
+	ret0, _ := ret[0].([]*model.GroupUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockGroupUsersRepositoryMockRecorder) FindByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockGroupUsersRepository)(nil).FindByUserId), ctx, userId)
+}
+
+// Insert mocks base method.
+func (m *MockGroupUsersRepository) Insert(ctx context.Context, record *model.GroupUsers) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockGroupUsersRepositoryMockRecorder) Insert(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockGroupUsersRepository)(nil).Insert), ctx, record)
+}
+
+// Update mocks base method.
+func (m *MockGroupUsersRepository) Update(ctx context.Context, id int64, update *model.UpdateGroupUsers) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGroupUsersRepositoryMockRecorder) Update(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGroupUsersRepository)(nil).Update), ctx, id, update)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_groups_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_groups_repository.go -destination=mock_model/user_groups_repository.go -package=mock_model
+//
+
+// Package mock_model is a generated GoMock package.
+package mock_model
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/loilo-inc/exql/v3/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserGroupsRepository is a mock of UserGroupsRepository interface.
+type MockUserGroupsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserGroupsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserGroupsRepositoryMockRecorder is the mock recorder for MockUserGroupsRepository.
+type MockUserGroupsRepositoryMockRecorder struct {
+	mock *MockUserGroupsRepository
+}
+
+// NewMockUserGroupsRepository creates a new mock instance.
+func NewMockUserGroupsRepository(ctrl *gomock.Controller) *MockUserGroupsRepository {
+	mock := &MockUserGroupsRepository{ctrl: ctrl}
+	mock.recorder = &MockUserGroupsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserGroupsRepository) EXPECT() *MockUserGroupsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUserGroupsRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserGroupsRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserGroupsRepository)(nil).Delete), ctx, id)
+}
+
+// FindById mocks base method.
+func (m *MockUserGroupsRepository) FindById(ctx context.Context, id int64) (*model.UserGroups, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*model.UserGroups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUserGroupsRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserGroupsRepository)(nil).FindById), ctx, id)
+}
+
+// Insert mocks base method.
+func (m *MockUserGroupsRepository) Insert(ctx context.Context, record *model.UserGroups) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockUserGroupsRepositoryMockRecorder) Insert(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockUserGroupsRepository)(nil).Insert), ctx, record)
+}
+
+// Update mocks base method.
+func (m *MockUserGroupsRepository) Update(ctx context.Context, id int64, update *model.UpdateUserGroups) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserGroupsRepositoryMockRecorder) Update(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserGroupsRepository)(nil).Update), ctx, id, update)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_login_histories_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_login_histories_repository.go -destination=mock_model/user_login_histories_repository.go -package=mock_model
+//
+
+// Package mock_model is a generated GoMock package.
+package mock_model
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/loilo-inc/exql/v3/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserLoginHistoriesRepository is a mock of UserLoginHistoriesRepository interface.
+type MockUserLoginHistoriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserLoginHistoriesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserLoginHistoriesRepositoryMockRecorder is the mock recorder for MockUserLoginHistoriesRepository.
+type MockUserLoginHistoriesRepositoryMockRecorder struct {
+	mock *MockUserLoginHistoriesRepository
+}
+
+// NewMockUserLoginHistoriesRepository creates a new mock instance.
+func NewMockUserLoginHistoriesRepository(ctrl *gomock.Controller) *MockUserLoginHistoriesRepository {
+	mock := &MockUserLoginHistoriesRepository{ctrl: ctrl}
+	mock.recorder = &MockUserLoginHistoriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserLoginHistoriesRepository) EXPECT() *MockUserLoginHistoriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUserLoginHistoriesRepository) Delete(ctx context.Context, id int64, createdAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, createdAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserLoginHistoriesRepositoryMockRecorder) Delete(ctx, id, createdAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserLoginHistoriesRepository)(nil).Delete), ctx, id, createdAt)
+}
+
+// FindByIdAndCreatedAt mocks base method.
+func (m *MockUserLoginHistoriesRepository) FindByIdAndCreatedAt(ctx context.Context, id int64, createdAt time.Time) (*model.UserLoginHistories, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdAndCreatedAt", ctx, id, createdAt)
+	ret0, _ := ret[0].(*model.UserLoginHistories)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdAndCreatedAt indicates an expected call of FindByIdAndCreatedAt.
+func (mr *MockUserLoginHistoriesRepositoryMockRecorder) FindByIdAndCreatedAt(ctx, id, createdAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdAndCreatedAt", reflect.TypeOf((*MockUserLoginHistoriesRepository)(nil).FindByIdAndCreatedAt), ctx, id, createdAt)
+}
+
+// Insert mocks base method.
+func (m *MockUserLoginHistoriesRepository) Insert(ctx context.Context, record *model.UserLoginHistories) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockUserLoginHistoriesRepositoryMockRecorder) Insert(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockUserLoginHistoriesRepository)(nil).Insert), ctx, record)
+}
+
+// Update mocks base method.
+func (m *MockUserLoginHistoriesRepository) Update(ctx context.Context, id int64, createdAt time.Time, update *model.UpdateUserLoginHistories) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, createdAt, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserLoginHistoriesRepositoryMockRecorder) Update(ctx, id, createdAt, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserLoginHistoriesRepository)(nil).Update), ctx, id, createdAt, update)
+}
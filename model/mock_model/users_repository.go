@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: users_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=users_repository.go -destination=mock_model/users_repository.go -package=mock_model
+//
+
+// Package mock_model is a generated GoMock package.
+package mock_model
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/loilo-inc/exql/v3/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsersRepository is a mock of UsersRepository interface.
+type MockUsersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUsersRepositoryMockRecorder is the mock recorder for MockUsersRepository.
+type MockUsersRepositoryMockRecorder struct {
+	mock *MockUsersRepository
+}
+
+// NewMockUsersRepository creates a new mock instance.
+func NewMockUsersRepository(ctrl *gomock.Controller) *MockUsersRepository {
+	mock := &MockUsersRepository{ctrl: ctrl}
+	mock.recorder = &MockUsersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUsersRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUsersRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsersRepository)(nil).Delete), ctx, id)
+}
+
+// FindById mocks base method.
+func (m *MockUsersRepository) FindById(ctx context.Context, id int64) (*model.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*model.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUsersRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUsersRepository)(nil).FindById), ctx, id)
+}
+
+// Insert mocks base method.
+func (m *MockUsersRepository) Insert(ctx context.Context, record *model.Users) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockUsersRepositoryMockRecorder) Insert(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockUsersRepository)(nil).Insert), ctx, record)
+}
+
+// Update mocks base method.
+func (m *MockUsersRepository) Update(ctx context.Context, id int64, update *model.UpdateUsers) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUsersRepositoryMockRecorder) Update(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsersRepository)(nil).Update), ctx, id, update)
+}
@@ -1,6 +1,11 @@
 // Code generated by exql. DO NOT EDIT.
 package model
 
+import "context"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/meta"
+import "github.com/loilo-inc/exql/v3/query"
+
 type UserGroups struct {
 	Id   int64  `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
 	Name string `exql:"column:name;type:varchar(255);not null" json:"name"`
@@ -11,7 +16,6 @@ func (u *UserGroups) TableName() string {
 }
 
 type UpdateUserGroups struct {
-	Id   *int64  `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
 	Name *string `exql:"column:name;type:varchar(255);not null" json:"name"`
 }
 
@@ -19,4 +23,150 @@ func (u *UpdateUserGroups) UpdateTableName() string {
 	return UserGroupsTableName
 }
 
+// NewUpdateUserGroups returns the empty UpdateUserGroups to set columns to be updated by setters.
+func NewUpdateUserGroups() *UpdateUserGroups {
+	return &UpdateUserGroups{}
+}
+
+// SetName sets name to be updated.
+func (u *UpdateUserGroups) SetName(v string) *UpdateUserGroups {
+	u.Name = &v
+	return u
+}
+
+// DiffUpdate returns UpdateUserGroups with the columns changed from u to after, or nil if nothing is changed.
+// Columns not in UpdateUserGroups are not compared.
+func (u *UserGroups) DiffUpdate(after *UserGroups) *UpdateUserGroups {
+	update := &UpdateUserGroups{}
+	changed := false
+	if !(u.Name == after.Name) {
+		update.SetName(after.Name)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return update
+}
+
+func (u *UserGroups) TableMetadata() *meta.Table {
+	return UserGroupsMetadata
+}
+
 const UserGroupsTableName = "user_groups"
+
+// Column names of UserGroups.
+const (
+	UserGroupsColumnId   = "id"
+	UserGroupsColumnName = "name"
+)
+
+// UserGroupsColumnNames are the column names of UserGroups in the table order.
+var UserGroupsColumnNames = []string{
+	UserGroupsColumnId,
+	UserGroupsColumnName,
+}
+
+// UserGroupsPrimaryKeyColumnNames are the column names of the primary key of UserGroups in the key order.
+var UserGroupsPrimaryKeyColumnNames = []string{
+	UserGroupsColumnId,
+}
+
+// UserGroupsColumns are the typed handles of the columns of UserGroups for building conditions.
+var UserGroupsColumns = struct {
+	Id   query.Column[int64]
+	Name query.Column[string]
+}{
+	Id:   query.NewColumn[int64](UserGroupsTableName, UserGroupsColumnId),
+	Name: query.NewColumn[string](UserGroupsTableName, UserGroupsColumnName),
+}
+
+// UserGroupsMetadata is the static metadata of UserGroups.
+var UserGroupsMetadata = &meta.Table{
+	Name:          UserGroupsTableName,
+	Columns:       UserGroupsColumnNames,
+	PrimaryKey:    UserGroupsPrimaryKeyColumnNames,
+	AutoIncrement: UserGroupsColumnId,
+}
+
+// UserGroupsRelations are the relations of UserGroups by foreign keys, to be passed to exql.Preload.
+var UserGroupsRelations = struct {
+	GroupUsers *meta.Relation
+}{
+	GroupUsers: &meta.Relation{
+		Kind:       meta.HasMany,
+		Table:      GroupUsersTableName,
+		LocalKey:   UserGroupsColumnId,
+		ForeignKey: GroupUsersColumnGroupId,
+	},
+}
+
+// LoadGroupUsers loads GroupUsers whose GroupId refers Id.
+func (u *UserGroups) LoadGroupUsers(ctx context.Context, finder iface.Finder) ([]*GroupUsers, error) {
+	var dest []*GroupUsers
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :? = ?",
+		query.Cols(GroupUsersTableName), query.Cols(GroupUsersColumnGroupId), u.Id,
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// UserGroupsKey is the primary key of UserGroups in the key order.
+type UserGroupsKey struct {
+	Id int64
+}
+
+// UserGroupsPrimaryKey is the handle of the primary key of UserGroups for building conditions.
+var UserGroupsPrimaryKey = query.NewKey(UserGroupsTableName, UserGroupsColumnId)
+
+// PrimaryKey returns the primary key of the model.
+func (u *UserGroups) PrimaryKey() UserGroupsKey {
+	return UserGroupsKey{
+		Id: u.Id,
+	}
+}
+
+// Cond returns the condition matching the record of the key.
+func (k UserGroupsKey) Cond() query.Condition {
+	return UserGroupsPrimaryKey.Eq(k.Id)
+}
+
+// UserGroupsKeysIn returns the condition matching the records of the keys.
+// It results in an error if keys is empty.
+func UserGroupsKeysIn(keys ...UserGroupsKey) query.Condition {
+	tuples := make([][]any, len(keys))
+	for i, k := range keys {
+		tuples[i] = []any{k.Id}
+	}
+	return UserGroupsPrimaryKey.In(tuples...)
+}
+
+// FindUserGroupsByKeys finds UserGroups by the primary keys. It returns an empty slice if not found.
+func FindUserGroupsByKeys(ctx context.Context, finder iface.Finder, keys ...UserGroupsKey) ([]*UserGroups, error) {
+	dest := []*UserGroups{}
+	if len(keys) == 0 {
+		return dest, nil
+	}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(UserGroupsTableName), UserGroupsKeysIn(keys...),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// FindUserGroupsById finds UserGroups by the primary key.
+// It returns exql.ErrRecordNotFound if not found.
+func FindUserGroupsById(ctx context.Context, finder iface.Finder, id int64) (*UserGroups, error) {
+	var dest UserGroups
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(UserGroupsTableName), UserGroupsColumns.Id.Eq(id),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
@@ -1,7 +1,11 @@
 // Code generated by exql. DO NOT EDIT.
 package model
 
+import "context"
 import "time"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/meta"
+import "github.com/loilo-inc/exql/v3/query"
 
 type UserLoginHistories struct {
 	Id        int64     `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
@@ -14,13 +18,140 @@ func (u *UserLoginHistories) TableName() string {
 }
 
 type UpdateUserLoginHistories struct {
-	Id        *int64     `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
-	UserId    *int64     `exql:"column:user_id;type:int;not null" json:"user_id"`
-	CreatedAt *time.Time `exql:"column:created_at;type:datetime;primary;not null" json:"created_at"`
+	UserId *int64 `exql:"column:user_id;type:int;not null" json:"user_id"`
 }
 
 func (u *UpdateUserLoginHistories) UpdateTableName() string {
 	return UserLoginHistoriesTableName
 }
 
+// NewUpdateUserLoginHistories returns the empty UpdateUserLoginHistories to set columns to be updated by setters.
+func NewUpdateUserLoginHistories() *UpdateUserLoginHistories {
+	return &UpdateUserLoginHistories{}
+}
+
+// SetUserId sets user_id to be updated.
+func (u *UpdateUserLoginHistories) SetUserId(v int64) *UpdateUserLoginHistories {
+	u.UserId = &v
+	return u
+}
+
+// DiffUpdate returns UpdateUserLoginHistories with the columns changed from u to after, or nil if nothing is changed.
+// Columns not in UpdateUserLoginHistories are not compared.
+func (u *UserLoginHistories) DiffUpdate(after *UserLoginHistories) *UpdateUserLoginHistories {
+	update := &UpdateUserLoginHistories{}
+	changed := false
+	if !(u.UserId == after.UserId) {
+		update.SetUserId(after.UserId)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return update
+}
+
+func (u *UserLoginHistories) TableMetadata() *meta.Table {
+	return UserLoginHistoriesMetadata
+}
+
 const UserLoginHistoriesTableName = "user_login_histories"
+
+// Column names of UserLoginHistories.
+const (
+	UserLoginHistoriesColumnId        = "id"
+	UserLoginHistoriesColumnUserId    = "user_id"
+	UserLoginHistoriesColumnCreatedAt = "created_at"
+)
+
+// UserLoginHistoriesColumnNames are the column names of UserLoginHistories in the table order.
+var UserLoginHistoriesColumnNames = []string{
+	UserLoginHistoriesColumnId,
+	UserLoginHistoriesColumnUserId,
+	UserLoginHistoriesColumnCreatedAt,
+}
+
+// UserLoginHistoriesPrimaryKeyColumnNames are the column names of the primary key of UserLoginHistories in the key order.
+var UserLoginHistoriesPrimaryKeyColumnNames = []string{
+	UserLoginHistoriesColumnId,
+	UserLoginHistoriesColumnCreatedAt,
+}
+
+// UserLoginHistoriesColumns are the typed handles of the columns of UserLoginHistories for building conditions.
+var UserLoginHistoriesColumns = struct {
+	Id        query.Column[int64]
+	UserId    query.Column[int64]
+	CreatedAt query.Column[time.Time]
+}{
+	Id:        query.NewColumn[int64](UserLoginHistoriesTableName, UserLoginHistoriesColumnId),
+	UserId:    query.NewColumn[int64](UserLoginHistoriesTableName, UserLoginHistoriesColumnUserId),
+	CreatedAt: query.NewColumn[time.Time](UserLoginHistoriesTableName, UserLoginHistoriesColumnCreatedAt),
+}
+
+// UserLoginHistoriesMetadata is the static metadata of UserLoginHistories.
+var UserLoginHistoriesMetadata = &meta.Table{
+	Name:          UserLoginHistoriesTableName,
+	Columns:       UserLoginHistoriesColumnNames,
+	PrimaryKey:    UserLoginHistoriesPrimaryKeyColumnNames,
+	AutoIncrement: UserLoginHistoriesColumnId,
+}
+
+// UserLoginHistoriesKey is the primary key of UserLoginHistories in the key order.
+type UserLoginHistoriesKey struct {
+	Id        int64
+	CreatedAt time.Time
+}
+
+// UserLoginHistoriesPrimaryKey is the handle of the primary key of UserLoginHistories for building conditions.
+var UserLoginHistoriesPrimaryKey = query.NewKey(UserLoginHistoriesTableName, UserLoginHistoriesColumnId, UserLoginHistoriesColumnCreatedAt)
+
+// PrimaryKey returns the primary key of the model.
+func (u *UserLoginHistories) PrimaryKey() UserLoginHistoriesKey {
+	return UserLoginHistoriesKey{
+		Id:        u.Id,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+// Cond returns the condition matching the record of the key.
+func (k UserLoginHistoriesKey) Cond() query.Condition {
+	return UserLoginHistoriesPrimaryKey.Eq(k.Id, k.CreatedAt)
+}
+
+// UserLoginHistoriesKeysIn returns the condition matching the records of the keys.
+// It results in an error if keys is empty.
+func UserLoginHistoriesKeysIn(keys ...UserLoginHistoriesKey) query.Condition {
+	tuples := make([][]any, len(keys))
+	for i, k := range keys {
+		tuples[i] = []any{k.Id, k.CreatedAt}
+	}
+	return UserLoginHistoriesPrimaryKey.In(tuples...)
+}
+
+// FindUserLoginHistoriesByKeys finds UserLoginHistories by the primary keys. It returns an empty slice if not found.
+func FindUserLoginHistoriesByKeys(ctx context.Context, finder iface.Finder, keys ...UserLoginHistoriesKey) ([]*UserLoginHistories, error) {
+	dest := []*UserLoginHistories{}
+	if len(keys) == 0 {
+		return dest, nil
+	}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(UserLoginHistoriesTableName), UserLoginHistoriesKeysIn(keys...),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// FindUserLoginHistoriesByIdAndCreatedAt finds UserLoginHistories by the primary key.
+// It returns exql.ErrRecordNotFound if not found.
+func FindUserLoginHistoriesByIdAndCreatedAt(ctx context.Context, finder iface.Finder, id int64, createdAt time.Time) (*UserLoginHistories, error) {
+	var dest UserLoginHistories
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :? AND :?",
+		query.Cols(UserLoginHistoriesTableName), UserLoginHistoriesColumns.Id.Eq(id), UserLoginHistoriesColumns.CreatedAt.Eq(createdAt),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
@@ -1,6 +1,11 @@
 // Code generated by exql. DO NOT EDIT.
 package model
 
+import "context"
+import "github.com/loilo-inc/exql/v3/iface"
+import "github.com/loilo-inc/exql/v3/meta"
+import "github.com/loilo-inc/exql/v3/query"
+
 type Users struct {
 	Id   int64  `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
 	Name string `exql:"column:name;type:varchar(255);not null" json:"name"`
@@ -12,7 +17,6 @@ func (u *Users) TableName() string {
 }
 
 type UpdateUsers struct {
-	Id   *int64  `exql:"column:id;type:int;primary;not null;auto_increment" json:"id"`
 	Name *string `exql:"column:name;type:varchar(255);not null" json:"name"`
 	Age  *int64  `exql:"column:age;type:int;not null" json:"age"`
 }
@@ -21,4 +25,164 @@ func (u *UpdateUsers) UpdateTableName() string {
 	return UsersTableName
 }
 
+// NewUpdateUsers returns the empty UpdateUsers to set columns to be updated by setters.
+func NewUpdateUsers() *UpdateUsers {
+	return &UpdateUsers{}
+}
+
+// SetName sets name to be updated.
+func (u *UpdateUsers) SetName(v string) *UpdateUsers {
+	u.Name = &v
+	return u
+}
+
+// SetAge sets age to be updated.
+func (u *UpdateUsers) SetAge(v int64) *UpdateUsers {
+	u.Age = &v
+	return u
+}
+
+// DiffUpdate returns UpdateUsers with the columns changed from u to after, or nil if nothing is changed.
+// Columns not in UpdateUsers are not compared.
+func (u *Users) DiffUpdate(after *Users) *UpdateUsers {
+	update := &UpdateUsers{}
+	changed := false
+	if !(u.Name == after.Name) {
+		update.SetName(after.Name)
+		changed = true
+	}
+	if !(u.Age == after.Age) {
+		update.SetAge(after.Age)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return update
+}
+
+func (u *Users) TableMetadata() *meta.Table {
+	return UsersMetadata
+}
+
 const UsersTableName = "users"
+
+// Column names of Users.
+const (
+	UsersColumnId   = "id"
+	UsersColumnName = "name"
+	UsersColumnAge  = "age"
+)
+
+// UsersColumnNames are the column names of Users in the table order.
+var UsersColumnNames = []string{
+	UsersColumnId,
+	UsersColumnName,
+	UsersColumnAge,
+}
+
+// UsersPrimaryKeyColumnNames are the column names of the primary key of Users in the key order.
+var UsersPrimaryKeyColumnNames = []string{
+	UsersColumnId,
+}
+
+// UsersColumns are the typed handles of the columns of Users for building conditions.
+var UsersColumns = struct {
+	Id   query.Column[int64]
+	Name query.Column[string]
+	Age  query.Column[int64]
+}{
+	Id:   query.NewColumn[int64](UsersTableName, UsersColumnId),
+	Name: query.NewColumn[string](UsersTableName, UsersColumnName),
+	Age:  query.NewColumn[int64](UsersTableName, UsersColumnAge),
+}
+
+// UsersMetadata is the static metadata of Users.
+var UsersMetadata = &meta.Table{
+	Name:          UsersTableName,
+	Columns:       UsersColumnNames,
+	PrimaryKey:    UsersPrimaryKeyColumnNames,
+	AutoIncrement: UsersColumnId,
+}
+
+// UsersRelations are the relations of Users by foreign keys, to be passed to exql.Preload.
+var UsersRelations = struct {
+	GroupUsers *meta.Relation
+}{
+	GroupUsers: &meta.Relation{
+		Kind:       meta.HasMany,
+		Table:      GroupUsersTableName,
+		LocalKey:   UsersColumnId,
+		ForeignKey: GroupUsersColumnUserId,
+	},
+}
+
+// LoadGroupUsers loads GroupUsers whose UserId refers Id.
+func (u *Users) LoadGroupUsers(ctx context.Context, finder iface.Finder) ([]*GroupUsers, error) {
+	var dest []*GroupUsers
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :? = ?",
+		query.Cols(GroupUsersTableName), query.Cols(GroupUsersColumnUserId), u.Id,
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// UsersKey is the primary key of Users in the key order.
+type UsersKey struct {
+	Id int64
+}
+
+// UsersPrimaryKey is the handle of the primary key of Users for building conditions.
+var UsersPrimaryKey = query.NewKey(UsersTableName, UsersColumnId)
+
+// PrimaryKey returns the primary key of the model.
+func (u *Users) PrimaryKey() UsersKey {
+	return UsersKey{
+		Id: u.Id,
+	}
+}
+
+// Cond returns the condition matching the record of the key.
+func (k UsersKey) Cond() query.Condition {
+	return UsersPrimaryKey.Eq(k.Id)
+}
+
+// UsersKeysIn returns the condition matching the records of the keys.
+// It results in an error if keys is empty.
+func UsersKeysIn(keys ...UsersKey) query.Condition {
+	tuples := make([][]any, len(keys))
+	for i, k := range keys {
+		tuples[i] = []any{k.Id}
+	}
+	return UsersPrimaryKey.In(tuples...)
+}
+
+// FindUsersByKeys finds Users by the primary keys. It returns an empty slice if not found.
+func FindUsersByKeys(ctx context.Context, finder iface.Finder, keys ...UsersKey) ([]*Users, error) {
+	dest := []*Users{}
+	if len(keys) == 0 {
+		return dest, nil
+	}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(UsersTableName), UsersKeysIn(keys...),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// FindUsersById finds Users by the primary key.
+// It returns exql.ErrRecordNotFound if not found.
+func FindUsersById(ctx context.Context, finder iface.Finder, id int64) (*Users, error) {
+	var dest Users
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols(UsersTableName), UsersColumns.Id.Eq(id),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
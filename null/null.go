@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"reflect"
 	"time"
+
+	"github.com/loilo-inc/exql/v3/decimal"
 )
 
 type Nuller interface {
@@ -124,3 +126,4 @@ type Time = Null[time.Time]
 type String = Null[string]
 type Bytes = Null[[]byte]
 type JSON = Null[json.RawMessage]
+type Decimal = Null[decimal.Decimal]
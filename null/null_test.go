@@ -324,3 +324,22 @@ func TestNullPtr(t *testing.T) {
 		assert.Equal(t, 2.71, *ptr)
 	})
 }
+
+func TestDecimal(t *testing.T) {
+	var n Decimal
+	assert.NoError(t, n.Scan([]byte("12.50")))
+	assert.True(t, n.Valid)
+	assert.Equal(t, "12.50", n.V.String())
+	v, err := n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "12.50", v)
+	data, err := n.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"12.50"`, string(data))
+
+	assert.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+	v, err = n.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
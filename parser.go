@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -28,12 +29,36 @@ type Column struct {
 	DefaultValue sql.NullString `json:"default_value"`
 	Key          sql.NullString `json:"key"`
 	Extra        sql.NullString `json:"extra"`
+	Comment      string         `json:"comment,omitempty"`
+	CharacterSet string         `json:"character_set,omitempty"`
+	Collation    string         `json:"collation,omitempty"`
+	// Imports are the paths of packages required by GoFieldType other than the standard ones for models.
+	Imports []string `json:"imports,omitempty"`
 }
 
 func (c *Column) IsPrimary() bool {
 	return c.Key.String == "PRI"
 }
 
+func (c *Column) IsAutoIncrement() bool {
+	return slices.Contains(c.ParseExtra(), "auto_increment")
+}
+
+// GoName is the name of the struct field for the column.
+func (c *Column) GoName() string {
+	return strcase.ToCamel(c.FieldName)
+}
+
+// HasNullType reports whether the column is nullable and the zero value of GoFieldType is NULL.
+func (c *Column) HasNullType() bool {
+	return c.Nullable && (strings.HasPrefix(c.GoFieldType, "null.") || strings.HasPrefix(c.GoFieldType, "*"))
+}
+
+// DocComment returns the column comment as the Go doc comment of the struct field, or empty if not commented.
+func (c *Column) DocComment() string {
+	return docComment(c.Comment, "\t")
+}
+
 func (c *Column) ParseExtra() []string {
 	comps := strings.Split(c.Extra.String, " ")
 	empty := regexp.MustCompile(`^\s*$`)
@@ -68,7 +93,7 @@ func (c *Column) field(goFiledType string) string {
 	}
 	tag = append(tag, c.ParseExtra()...)
 	return fmt.Sprintf("%s %s `exql:\"%s\" json:\"%s\"`",
-		strcase.ToCamel(c.FieldName),
+		c.GoName(),
 		goFiledType,
 		strings.Join(tag, ";"),
 		strcase.ToSnake(c.FieldName),
@@ -79,7 +104,7 @@ func (p *parser) ParseTable(db *sql.DB, table string) (*Table, error) {
 	if strings.ContainsRune(table, '`') {
 		return nil, fmt.Errorf("invalid table name: %q", table)
 	}
-	rows, err := db.Query(fmt.Sprintf("show columns from `%s`", table))
+	rows, err := db.Query(fmt.Sprintf("show full columns from `%s`", table))
 	if err != nil {
 		return nil, err
 	}
@@ -89,17 +114,22 @@ func (p *parser) ParseTable(db *sql.DB, table string) (*Table, error) {
 	for rows.Next() {
 		field := ""
 		_type := ""
+		collation := sql.NullString{}
 		_null := sql.NullString{}
 		key := sql.NullString{}
 		_default := sql.NullString{}
 		extra := sql.NullString{}
-		if err := rows.Scan(&field, &_type, &_null, &key, &_default, &extra); err != nil {
+		privileges := sql.NullString{}
+		comment := sql.NullString{}
+		if err := rows.Scan(&field, &_type, &collation, &_null, &key, &_default, &extra, &privileges, &comment); err != nil {
 			return nil, err
 		}
 		parsedType, err := ParseType(_type, _null.String == "YES")
 		if err != nil {
 			return nil, err
 		}
+		// Collations are named after their character sets, e.g. utf8mb4_bin
+		charset, _, _ := strings.Cut(collation.String, "_")
 		cols = append(cols, &Column{
 			FieldName:    field,
 			FieldType:    _type,
@@ -109,31 +139,66 @@ func (p *parser) ParseTable(db *sql.DB, table string) (*Table, error) {
 			DefaultValue: _default,
 			Key:          key,
 			Extra:        extra,
+			Comment:      comment.String,
+			CharacterSet: charset,
+			Collation:    collation.String,
 		})
 		i++
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+	var tableComment, tableType string
+	if err := db.QueryRow(
+		"select table_comment, table_type from information_schema.tables where table_schema = database() and table_name = ?",
+		table,
+	).Scan(&tableComment, &tableType); err == sql.ErrNoRows {
+		return nil, fmt.Errorf("table not found: %s", table)
+	} else if err != nil {
+		return nil, err
+	}
+	if tableType == "VIEW" {
+		// Views are commented as "VIEW" by MySQL
+		tableComment = ""
+	}
+	// SHOW COLUMNS doesn't tell the order of columns in composite keys
+	indexes, err := (&informationSchemaParser{}).parseIndexes(db, table)
+	if err != nil {
+		return nil, err
+	}
 	return &Table{
 		TableName: table,
+		View:      tableType == "VIEW",
+		Comment:   tableComment,
 		Columns:   cols,
+		Indexes:   indexes,
 	}, nil
 }
 
 var (
-	intPat    = regexp.MustCompile(`^(tiny|small|medium|big)?int(\(\d+?\))?( unsigned)?( zerofill)?$`)
-	floatPat  = regexp.MustCompile(`^float$`)
-	doublePat = regexp.MustCompile(`^double$`)
-	charPat   = regexp.MustCompile(`^(var)?char\(\d+?\)$`)
-	textPat   = regexp.MustCompile(`^(tiny|medium|long)?text$`)
-	blobPat   = regexp.MustCompile(`^(tiny|medium|long)?blob$`)
-	datePat   = regexp.MustCompile(`^(date|datetime|datetime\(\d\)|timestamp|timestamp\(\d\))$`)
-	timePat   = regexp.MustCompile(`^(time|time\(\d\))$`)
-	jsonPat   = regexp.MustCompile(`^json$`)
+	boolPat    = regexp.MustCompile(`^(tinyint\(1\)|bool|boolean)$`)
+	intPat     = regexp.MustCompile(`^(tiny|small|medium|big)?int(\(\d+?\))?( unsigned)?( zerofill)?$`)
+	yearPat    = regexp.MustCompile(`^year(\(\d\))?$`)
+	floatPat   = regexp.MustCompile(`^float(\(\d+(,\d+)?\))?( unsigned)?( zerofill)?$`)
+	doublePat  = regexp.MustCompile(`^(double|real)(\(\d+,\d+\))?( unsigned)?( zerofill)?$`)
+	decimalPat = regexp.MustCompile(`^(decimal|numeric)(\(\d+(,\d+)?\))?( unsigned)?( zerofill)?$`)
+	charPat    = regexp.MustCompile(`^(var)?char\(\d+?\)$`)
+	textPat    = regexp.MustCompile(`^(tiny|medium|long)?text$`)
+	enumPat    = regexp.MustCompile(`^(enum|set)\(.*\)$`)
+	blobPat    = regexp.MustCompile(`^(tiny|medium|long)?blob$`)
+	binaryPat  = regexp.MustCompile(`^(var)?binary\(\d+?\)$`)
+	bitPat     = regexp.MustCompile(`^bit(\(\d+?\))?$`)
+	spatialPat = regexp.MustCompile(`^(geometry|point|linestring|polygon|multipoint|multilinestring|multipolygon|geometrycollection|geomcollection)$`)
+	datePat    = regexp.MustCompile(`^(date|datetime|datetime\(\d\)|timestamp|timestamp\(\d\))$`)
+	timePat    = regexp.MustCompile(`^(time|time\(\d\))$`)
+	jsonPat    = regexp.MustCompile(`^json$`)
 )
 
 const (
+	nullBoolType    = "null.Bool"
+	boolType        = "bool"
+	nullDecimalType = "null.Decimal"
+	decimalType     = "decimal.Decimal"
 	nullUint64Type  = "null.Uint64"
 	nullInt64Type   = "null.Int64"
 	uint64Type      = "uint64"
@@ -152,8 +217,54 @@ const (
 	jsonType        = "json.RawMessage"
 )
 
+// EnumValues returns the values of ENUM or SET column types in the definition order,
+// or nil for other types.
+func (c *Column) EnumValues() []string {
+	m := enumPat.FindStringSubmatch(c.FieldType)
+	if m == nil {
+		return nil
+	}
+	body := strings.TrimSuffix(strings.TrimPrefix(c.FieldType, m[1]+"("), ")")
+	var values []string
+	for i := 0; i < len(body); i++ {
+		if body[i] != '\'' {
+			continue
+		}
+		var sb strings.Builder
+		for i++; i < len(body); i++ {
+			if body[i] == '\\' && i+1 < len(body) {
+				i++
+				sb.WriteByte(body[i])
+			} else if body[i] == '\'' && i+1 < len(body) && body[i+1] == '\'' {
+				i++
+				sb.WriteByte('\'')
+			} else if body[i] == '\'' {
+				break
+			} else {
+				sb.WriteByte(body[i])
+			}
+		}
+		values = append(values, sb.String())
+	}
+	return values
+}
+
+// IsSet reports whether the column type is SET.
+func (c *Column) IsSet() bool {
+	m := enumPat.FindStringSubmatch(c.FieldType)
+	return m != nil && m[1] == "set"
+}
+
+// ParseType returns the Go type for the MySQL column type t.
+// tinyint(1) is mapped to bool, decimal to decimal.Decimal, enum and set to string,
+// and binary, bit and spatial types to []byte in the internal format of MySQL.
 func ParseType(t string, nullable bool) (string, error) {
-	if intPat.MatchString(t) {
+	if boolPat.MatchString(t) {
+		if nullable {
+			return nullBoolType, nil
+		}
+		return boolType, nil
+	} else if intPat.MatchString(t) || yearPat.MatchString(t) {
 		m := intPat.FindStringSubmatch(t)
 		unsigned := strings.Contains(t, "unsigned")
 		is64 := false
@@ -187,7 +298,7 @@ func ParseType(t string, nullable bool) (string, error) {
 			return nullStrType, nil
 		}
 		return strType, nil
-	} else if textPat.MatchString(t) || charPat.MatchString(t) {
+	} else if textPat.MatchString(t) || charPat.MatchString(t) || enumPat.MatchString(t) {
 		if nullable {
 			return nullStrType, nil
 		}
@@ -202,7 +313,12 @@ func ParseType(t string, nullable bool) (string, error) {
 			return nullFloat64Type, nil
 		}
 		return float64Type, nil
-	} else if blobPat.MatchString(t) {
+	} else if decimalPat.MatchString(t) {
+		if nullable {
+			return nullDecimalType, nil
+		}
+		return decimalType, nil
+	} else if blobPat.MatchString(t) || binaryPat.MatchString(t) || bitPat.MatchString(t) || spatialPat.MatchString(t) {
 		if nullable {
 			return nullBytesType, nil
 		}
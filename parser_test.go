@@ -1,6 +1,7 @@
 package exql
 
 import (
+	"database/sql"
 	"fmt"
 	"testing"
 
@@ -9,6 +10,119 @@ import (
 )
 
 func TestParser_ParseTable(t *testing.T) {
+	str := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	columns := []string{"Field", "Type", "Collation", "Null", "Key", "Default", "Extra", "Privileges", "Comment"}
+	t.Run("basic", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+
+		mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+			sqlmock.NewRows(columns).
+				AddRow("id", "int", nil, "NO", "PRI", nil, "auto_increment", "select", "ID").
+				AddRow("name", "varchar(64)", "utf8mb4_bin", "YES", "", "a", "", "select", ""))
+		mock.ExpectQuery("from information_schema.tables").WithArgs("users").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("all users", "BASE TABLE"))
+		mock.ExpectQuery("from information_schema.statistics").WithArgs("users").
+			WillReturnRows(sqlmock.NewRows([]string{"index_name", "non_unique", "column_name"}).
+				AddRow("PRIMARY", false, "id").
+				AddRow("name", true, "name"))
+
+		table, err := NewParser().ParseTable(mockDb, "users")
+		assert.NoError(t, err)
+		assert.Equal(t, &Table{
+			TableName: "users",
+			Comment:   "all users",
+			Columns: []*Column{
+				{
+					FieldName: "id", FieldType: "int", FieldIndex: 0, GoFieldType: "int64",
+					Key: str("PRI"), Extra: str("auto_increment"), Comment: "ID",
+				},
+				{
+					FieldName: "name", FieldType: "varchar(64)", FieldIndex: 1, GoFieldType: "null.String", Nullable: true,
+					DefaultValue: str("a"), Key: str(""), Extra: str(""), CharacterSet: "utf8mb4", Collation: "utf8mb4_bin",
+				},
+			},
+			Indexes: []*Index{
+				{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true},
+				{Name: "name", Columns: []string{"name"}},
+			},
+		}, table)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("composite primary key out of column order", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+
+		mock.ExpectQuery("show full columns from `histories`").WillReturnRows(
+			sqlmock.NewRows(columns).
+				AddRow("a", "int", nil, "NO", "PRI", nil, "", "select", "").
+				AddRow("b", "int", nil, "NO", "PRI", nil, "", "select", ""))
+		mock.ExpectQuery("from information_schema.tables").WithArgs("histories").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("", "BASE TABLE"))
+		mock.ExpectQuery("from information_schema.statistics").WithArgs("histories").
+			WillReturnRows(sqlmock.NewRows([]string{"index_name", "non_unique", "column_name"}).
+				AddRow("PRIMARY", false, "b").
+				AddRow("PRIMARY", false, "a"))
+
+		table, err := NewParser().ParseTable(mockDb, "histories")
+		assert.NoError(t, err)
+		var keys []string
+		for _, c := range table.PrimaryKeyColumns() {
+			keys = append(keys, c.FieldName)
+		}
+		assert.Equal(t, []string{"b", "a"}, keys)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("should return error if statistics query fails", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+
+		mock.ExpectQuery("show full columns from `users`").WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("id", "int", nil, "NO", "PRI", nil, "", "select", ""))
+		mock.ExpectQuery("from information_schema.tables").WithArgs("users").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("", "BASE TABLE"))
+		mock.ExpectQuery("from information_schema.statistics").WillReturnError(fmt.Errorf("err"))
+
+		table, err := NewParser().ParseTable(mockDb, "users")
+		assert.Nil(t, table)
+		assert.EqualError(t, err, "err")
+	})
+	t.Run("view", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+
+		mock.ExpectQuery("show full columns from `active_users`").WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("id", "int", nil, "NO", "", nil, "", "select", ""))
+		mock.ExpectQuery("from information_schema.tables").WithArgs("active_users").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}).AddRow("VIEW", "VIEW"))
+		mock.ExpectQuery("from information_schema.statistics").WithArgs("active_users").
+			WillReturnRows(sqlmock.NewRows([]string{"index_name", "non_unique", "column_name"}))
+
+		table, err := NewParser().ParseTable(mockDb, "active_users")
+		assert.NoError(t, err)
+		assert.True(t, table.View)
+		assert.Equal(t, "", table.Comment)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("should return error if table not found", func(t *testing.T) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer mockDb.Close()
+
+		mock.ExpectQuery("show full columns from `users`").WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectQuery("from information_schema.tables").WithArgs("users").
+			WillReturnRows(sqlmock.NewRows([]string{"table_comment", "table_type"}))
+
+		table, err := NewParser().ParseTable(mockDb, "users")
+		assert.Nil(t, table)
+		assert.EqualError(t, err, "table not found: users")
+	})
 	t.Run("should return error when rows.Error() return error", func(t *testing.T) {
 		mockDb, mock, err := sqlmock.New()
 		assert.NoError(t, err)
@@ -16,7 +130,7 @@ func TestParser_ParseTable(t *testing.T) {
 
 		p := NewParser()
 
-		mock.ExpectQuery("show columns from `users`").WillReturnRows(
+		mock.ExpectQuery("show full columns from `users`").WillReturnRows(
 			sqlmock.NewRows([]string{"field", "type"}).
 				AddRow("id", "int(11)").
 				RowError(0, fmt.Errorf("err")))
@@ -59,20 +173,42 @@ func TestParser_ParseType(t *testing.T) {
 		for _, v := range list {
 			title := v[0].(string)
 			t.Run(title, func(t *testing.T) {
-				assertType(fmt.Sprintf("%s(1)", title), false, v[1])
+				// tinyint(1) is bool
+				assertType(fmt.Sprintf("%s(2)", title), false, v[1])
 				assertType(fmt.Sprintf("%s(1) unsigned", title), false, v[2])
-				assertType(fmt.Sprintf("%s(1)", title), true, v[3])
+				assertType(fmt.Sprintf("%s(2)", title), true, v[3])
 				assertType(fmt.Sprintf("%s(1) unsigned", title), true, v[4])
+				assertType(title, false, v[1])
 			})
 		}
 	})
+	t.Run("bool", func(t *testing.T) {
+		for _, s := range []string{"tinyint(1)", "bool", "boolean"} {
+			assertType(s, false, "bool")
+			assertType(s, true, "null.Bool")
+		}
+	})
+	t.Run("year", func(t *testing.T) {
+		assertType("year", false, "int64")
+		assertType("year(4)", true, "null.Int64")
+	})
 	t.Run("float", func(t *testing.T) {
-		assertType("float", false, "float32")
-		assertType("float", true, "null.Float32")
+		for _, s := range []string{"float", "float(7,4)", "float(24)", "float unsigned"} {
+			assertType(s, false, "float32")
+			assertType(s, true, "null.Float32")
+		}
 	})
 	t.Run("double", func(t *testing.T) {
-		assertType("double", false, "float64")
-		assertType("double", true, "null.Float64")
+		for _, s := range []string{"double", "double(16,4)", "real", "double unsigned zerofill"} {
+			assertType(s, false, "float64")
+			assertType(s, true, "null.Float64")
+		}
+	})
+	t.Run("decimal", func(t *testing.T) {
+		for _, s := range []string{"decimal(10,2)", "decimal(10)", "decimal", "numeric(5,0)", "decimal(10,2) unsigned zerofill"} {
+			assertType(s, false, "decimal.Decimal")
+			assertType(s, true, "null.Decimal")
+		}
 	})
 	t.Run("date", func(t *testing.T) {
 		list := [][]any{
@@ -98,6 +234,8 @@ func TestParser_ParseType(t *testing.T) {
 			{"longtext", "string", "null.String"},
 			{"char(10)", "string", "null.String"},
 			{"varchar(255)", "string", "null.String"},
+			{"enum('a','b')", "string", "null.String"},
+			{"set('a','b,c')", "string", "null.String"},
 		}
 		for _, v := range list {
 			t := v[0].(string)
@@ -111,6 +249,14 @@ func TestParser_ParseType(t *testing.T) {
 			{"tinyblob", "[]byte", "null.Bytes"},
 			{"mediumblob", "[]byte", "null.Bytes"},
 			{"longblob", "[]byte", "null.Bytes"},
+			{"binary(16)", "[]byte", "null.Bytes"},
+			{"varbinary(255)", "[]byte", "null.Bytes"},
+			{"bit", "[]byte", "null.Bytes"},
+			{"bit(8)", "[]byte", "null.Bytes"},
+			{"geometry", "[]byte", "null.Bytes"},
+			{"point", "[]byte", "null.Bytes"},
+			{"multipolygon", "[]byte", "null.Bytes"},
+			{"geomcollection", "[]byte", "null.Bytes"},
 		}
 		for _, v := range list {
 			t := v[0].(string)
@@ -128,3 +274,24 @@ func TestParser_ParseType(t *testing.T) {
 		assert.EqualError(t, err, "unknown type: unknown")
 	})
 }
+
+func TestColumn_EnumValues(t *testing.T) {
+	list := []struct {
+		fieldType string
+		values    []string
+		set       bool
+	}{
+		{"enum('active','banned')", []string{"active", "banned"}, false},
+		{"set('a','b','c')", []string{"a", "b", "c"}, true},
+		{"enum('it''s','a,b','')", []string{"it's", "a,b", ""}, false},
+		{`enum('a\\b')`, []string{`a\b`}, false},
+		{"varchar(255)", nil, false},
+	}
+	for _, v := range list {
+		t.Run(v.fieldType, func(t *testing.T) {
+			c := &Column{FieldType: v.fieldType}
+			assert.Equal(t, v.values, c.EnumValues())
+			assert.Equal(t, v.set, c.IsSet())
+		})
+	}
+}
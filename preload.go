@@ -0,0 +1,321 @@
+package exql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/loilo-inc/exql/v3/iface"
+	"github.com/loilo-inc/exql/v3/meta"
+	q "github.com/loilo-inc/exql/v3/query"
+)
+
+// RelationKind is the kind of the relation between models.
+type RelationKind = meta.RelationKind
+
+const (
+	BelongsTo  = meta.BelongsTo
+	HasOne     = meta.HasOne
+	HasMany    = meta.HasMany
+	ManyToMany = meta.ManyToMany
+)
+
+// Relation describes how related models are loaded for parents. See meta.Relation.
+// Generated models provide relations by foreign keys, e.g. model.GroupUsersRelations.User.
+type Relation = meta.Relation
+
+// preloadParentKeyColumn is the alias of the parent key column in the query for ManyToMany.
+const preloadParentKeyColumn = "exql_parent_key"
+
+// Preload loads related models of all parents with a single query and assigns them into
+// the field of each parent. parents MUST BE a slice of pointer of struct or a pointer of it.
+// Columns of the parent are resolved through the embedded structs, so that
+// the parent can be made by embedding the generated model.
+// Parents without related models get nil for *Model fields and an empty slice for []*Model fields.
+//
+// Example:
+//
+//	type UserWithGroups struct {
+//		model.Users
+//		Groups []*model.UserGroups
+//	}
+//	var users []*UserWithGroups
+//	err := exql.Preload(ctx, db, users, "Groups", &exql.Relation{
+//		Kind: exql.ManyToMany, LocalKey: "id",
+//		Through: "group_users", ForeignKey: "user_id", ThroughKey: "group_id", TargetKey: "id",
+//	})
+func Preload(
+	ctx context.Context,
+	f Finder,
+	parents any,
+	field string,
+	rel *Relation,
+) error {
+	if rel == nil {
+		return fmt.Errorf("nil relation")
+	}
+	parentsValue, parentType, err := resolvePreloadParents(parents)
+	if err != nil {
+		return err
+	}
+	destField, ok := parentType.FieldByName(field)
+	if !ok {
+		return fmt.Errorf("field not found: %s", field)
+	}
+	childType, err := resolvePreloadChild(destField.Type, rel.Kind)
+	if err != nil {
+		return err
+	}
+	localKey, err := fieldIndexByColumn(parentType, rel.LocalKey)
+	if err != nil {
+		return err
+	}
+	table := rel.Table
+	if table == "" {
+		if m, ok := reflect.New(childType).Interface().(Model); ok {
+			table = m.TableName()
+		} else {
+			return fmt.Errorf("empty table for relation")
+		}
+	}
+	var keys []any
+	seen := map[any]struct{}{}
+	for i := 0; i < parentsValue.Len(); i++ {
+		p := parentsValue.Index(i)
+		if p.IsNil() {
+			continue
+		}
+		if key, ok := relationKey(p.Elem().FieldByIndex(localKey)); ok {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	children := map[any][]reflect.Value{}
+	if len(keys) > 0 {
+		if rel.Kind == ManyToMany {
+			children, err = preloadThrough(ctx, f, table, rel, childType, parentType.FieldByIndex(localKey).Type, keys)
+		} else {
+			children, err = preloadDirect(ctx, f, table, rel, childType, keys)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for i := 0; i < parentsValue.Len(); i++ {
+		p := parentsValue.Index(i)
+		if p.IsNil() {
+			continue
+		}
+		dest := p.Elem().FieldByIndex(destField.Index)
+		var list []reflect.Value
+		if key, ok := relationKey(p.Elem().FieldByIndex(localKey)); ok {
+			list = children[key]
+		}
+		switch rel.Kind {
+		case BelongsTo, HasOne:
+			if len(list) > 0 {
+				dest.Set(list[0])
+			} else {
+				dest.Set(reflect.Zero(dest.Type()))
+			}
+		default:
+			s := reflect.MakeSlice(dest.Type(), 0, len(list))
+			s = reflect.Append(s, list...)
+			dest.Set(s)
+		}
+	}
+	return nil
+}
+
+// preloadDirect loads related models that have the key column by themselves.
+func preloadDirect(
+	ctx context.Context,
+	f Finder,
+	table string,
+	rel *Relation,
+	childType reflect.Type,
+	keys []any,
+) (map[any][]reflect.Value, error) {
+	foreignKey, err := fieldIndexByColumn(childType, rel.ForeignKey)
+	if err != nil {
+		return nil, err
+	}
+	b := q.NewBuilder()
+	b.Query("SELECT * FROM :? WHERE :? IN (:?)", q.Cols(table), q.Cols(rel.ForeignKey), q.Vals(keys))
+	// var dest []*Child
+	dest := reflect.New(reflect.SliceOf(reflect.PointerTo(childType)))
+	if err := iface.FindManyOrEmptyContext(ctx, f, b.Build(), dest.Interface()); err != nil {
+		return nil, err
+	}
+	children := map[any][]reflect.Value{}
+	for i := 0; i < dest.Elem().Len(); i++ {
+		child := dest.Elem().Index(i)
+		if key, ok := relationKey(child.Elem().FieldByIndex(foreignKey)); ok {
+			children[key] = append(children[key], child)
+		}
+	}
+	return children, nil
+}
+
+// preloadThrough loads related models joined through the join table.
+func preloadThrough(
+	ctx context.Context,
+	f Finder,
+	table string,
+	rel *Relation,
+	childType reflect.Type,
+	keyType reflect.Type,
+	keys []any,
+) (map[any][]reflect.Value, error) {
+	if rel.Through == "" || rel.ThroughKey == "" || rel.TargetKey == "" {
+		return nil, fmt.Errorf("through, through key and target key are required for many-to-many relation")
+	}
+	// Row type that has all columns of the child and the parent key
+	var fields []reflect.StructField
+	var childFields []int
+	for i := 0; i < childType.NumField(); i++ {
+		f := childType.Field(i)
+		tag := f.Tag.Get("exql")
+		if tag == "" {
+			continue
+		}
+		if tags, err := ParseTags(tag); err != nil {
+			return nil, err
+		} else if isRelationTags(tags) {
+			continue
+		}
+		if !f.IsExported() {
+			return nil, fmt.Errorf("field must be exported: %s", f.Name)
+		}
+		fields = append(fields, reflect.StructField{Name: f.Name, Type: f.Type, Tag: f.Tag})
+		childFields = append(childFields, i)
+	}
+	fields = append(fields, reflect.StructField{
+		Name: "ExqlParentKey",
+		Type: keyType,
+		Tag:  reflect.StructTag(fmt.Sprintf(`exql:"column:%s"`, preloadParentKeyColumn)),
+	})
+	rowType := reflect.StructOf(fields)
+	b := q.NewBuilder()
+	b.Query("SELECT :?, :? AS :? FROM :?", q.Cols(table+".*"), q.Cols(rel.Through+"."+rel.ForeignKey), q.Cols(preloadParentKeyColumn), q.Cols(table))
+	b.Query("JOIN :? ON :? = :?", q.Cols(rel.Through), q.Cols(rel.Through+"."+rel.ThroughKey), q.Cols(table+"."+rel.TargetKey))
+	b.Query("WHERE :? IN (:?)", q.Cols(rel.Through+"."+rel.ForeignKey), q.Vals(keys))
+	// var dest []*Row
+	dest := reflect.New(reflect.SliceOf(reflect.PointerTo(rowType)))
+	if err := iface.FindManyOrEmptyContext(ctx, f, b.Build(), dest.Interface()); err != nil {
+		return nil, err
+	}
+	children := map[any][]reflect.Value{}
+	for i := 0; i < dest.Elem().Len(); i++ {
+		row := dest.Elem().Index(i).Elem()
+		child := reflect.New(childType)
+		for j, fIndex := range childFields {
+			child.Elem().Field(fIndex).Set(row.Field(j))
+		}
+		if key, ok := relationKey(row.Field(len(childFields))); ok {
+			children[key] = append(children[key], child)
+		}
+	}
+	return children, nil
+}
+
+var errPreloadParents = fmt.Errorf("parents must be a slice of pointer of struct")
+
+// resolvePreloadParents validates that the input is []*Model or *[]*Model and returns the slice and Model type.
+func resolvePreloadParents(parents any) (reflect.Value, reflect.Type, error) {
+	if parents == nil {
+		return reflect.Value{}, nil, errPreloadParents
+	}
+	v := reflect.ValueOf(parents)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice ||
+		v.Type().Elem().Kind() != reflect.Pointer ||
+		v.Type().Elem().Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, nil, errPreloadParents
+	}
+	return v, v.Type().Elem().Elem(), nil
+}
+
+// resolvePreloadChild returns the related model type for the destination field.
+func resolvePreloadChild(t reflect.Type, kind RelationKind) (reflect.Type, error) {
+	switch kind {
+	case BelongsTo, HasOne:
+		if t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Struct {
+			return t.Elem(), nil
+		}
+		return nil, fmt.Errorf("field must be a pointer of struct: %s", t)
+	case HasMany, ManyToMany:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Pointer && t.Elem().Elem().Kind() == reflect.Struct {
+			return t.Elem().Elem(), nil
+		}
+		return nil, fmt.Errorf("field must be a slice of pointer of struct: %s", t)
+	}
+	return nil, fmt.Errorf("unknown relation kind: %d", kind)
+}
+
+// fieldIndexByColumn finds the field tagged with the column, walking through embedded structs.
+func fieldIndexByColumn(t reflect.Type, col string) ([]int, error) {
+	if index, ok := findFieldIndexByColumn(t, col); ok {
+		return index, nil
+	}
+	return nil, fmt.Errorf("column not found in %s: %s", t, col)
+}
+
+func findFieldIndexByColumn(t reflect.Type, col string) ([]int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if index, ok := findFieldIndexByColumn(f.Type, col); ok {
+				return append([]int{i}, index...), true
+			}
+			continue
+		}
+		tag := f.Tag.Get("exql")
+		if tag == "" {
+			continue
+		}
+		if tags, err := ParseTags(tag); err == nil && tags["column"] == col {
+			return []int{i}, true
+		}
+	}
+	return nil, false
+}
+
+// relationKey normalizes the key value so that keys of different Go types can be compared,
+// e.g. int64 and null.Int64. It returns false if the value is NULL.
+func relationKey(v reflect.Value) (any, bool) {
+	i := v.Interface()
+	if valuer, ok := i.(driver.Valuer); ok {
+		dv, err := valuer.Value()
+		if err != nil || dv == nil {
+			return nil, false
+		}
+		i = dv
+	}
+	rv := reflect.ValueOf(i)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u := rv.Uint(); u <= math.MaxInt64 {
+			return int64(u), true
+		}
+		return rv.Uint(), true
+	case reflect.String:
+		return rv.String(), true
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return string(rv.Bytes()), true
+		}
+	}
+	if !rv.Comparable() {
+		return fmt.Sprint(i), true
+	}
+	return i, true
+}
@@ -0,0 +1,167 @@
+package exql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/null"
+	"github.com/stretchr/testify/assert"
+)
+
+type preloadUser struct {
+	model.Users
+	GroupUsers []*model.GroupUsers
+	Groups     []*model.UserGroups
+	Latest     *model.UserLoginHistories
+}
+
+type preloadGroupUser struct {
+	model.GroupUsers
+	User *model.Users
+}
+
+func TestPreload(t *testing.T) {
+	ctx := context.Background()
+	setup := func(t *testing.T) (Finder, sqlmock.Sqlmock) {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		t.Cleanup(func() {
+			assert.NoError(t, mock.ExpectationsWereMet())
+			mockDb.Close()
+		})
+		return NewFinder(mockDb), mock
+	}
+	users := func() []*preloadUser {
+		return []*preloadUser{
+			{Users: model.Users{Id: 1, Name: "user1"}},
+			{Users: model.Users{Id: 2, Name: "user2"}},
+			nil,
+			{Users: model.Users{Id: 1, Name: "user1"}},
+		}
+	}
+	t.Run("belongs to", func(t *testing.T) {
+		f, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE `id` IN (?,?)")).
+			WithArgs(int64(1), int64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}).AddRow(1, "user1", 10))
+		members := []*preloadGroupUser{
+			{GroupUsers: model.GroupUsers{Id: 1, UserId: 1}},
+			{GroupUsers: model.GroupUsers{Id: 2, UserId: 2}},
+		}
+		err := Preload(ctx, f, members, "User", &Relation{Kind: BelongsTo, LocalKey: "user_id", ForeignKey: "id"})
+		assert.NoError(t, err)
+		assert.Equal(t, &model.Users{Id: 1, Name: "user1", Age: 10}, members[0].User)
+		assert.Nil(t, members[1].User)
+	})
+	t.Run("has one", func(t *testing.T) {
+		f, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_login_histories` WHERE `user_id` IN (?,?)")).
+			WithArgs(int64(1), int64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(3, 2))
+		list := users()
+		err := Preload(ctx, f, &list, "Latest", &Relation{Kind: HasOne, LocalKey: "id", ForeignKey: "user_id"})
+		assert.NoError(t, err)
+		assert.Nil(t, list[0].Latest)
+		assert.Equal(t, &model.UserLoginHistories{Id: 3, UserId: 2}, list[1].Latest)
+	})
+	t.Run("has many", func(t *testing.T) {
+		f, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `group_users` WHERE `user_id` IN (?,?)")).
+			WithArgs(int64(1), int64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "group_id"}).
+				AddRow(1, 1, 10).
+				AddRow(2, 1, 11))
+		list := users()
+		err := Preload(ctx, f, list, "GroupUsers", &Relation{Kind: HasMany, LocalKey: "id", ForeignKey: "user_id"})
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.GroupUsers{{Id: 1, UserId: 1, GroupId: 10}, {Id: 2, UserId: 1, GroupId: 11}}, list[0].GroupUsers)
+		assert.Equal(t, []*model.GroupUsers{}, list[1].GroupUsers)
+		assert.Nil(t, list[2])
+		assert.Equal(t, list[0].GroupUsers, list[3].GroupUsers)
+	})
+	t.Run("many to many", func(t *testing.T) {
+		f, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta(
+			"SELECT `user_groups`.*, `group_users`.`user_id` AS `exql_parent_key` FROM `user_groups` "+
+				"JOIN `group_users` ON `group_users`.`group_id` = `user_groups`.`id` "+
+				"WHERE `group_users`.`user_id` IN (?,?)")).
+			WithArgs(int64(1), int64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "exql_parent_key"}).
+				AddRow(10, "group10", 1).
+				AddRow(11, "group11", 1).
+				AddRow(10, "group10", 2))
+		list := users()
+		err := Preload(ctx, f, list, "Groups", &Relation{
+			Kind: ManyToMany, LocalKey: "id",
+			Through: "group_users", ForeignKey: "user_id", ThroughKey: "group_id", TargetKey: "id",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.UserGroups{{Id: 10, Name: "group10"}, {Id: 11, Name: "group11"}}, list[0].Groups)
+		assert.Equal(t, []*model.UserGroups{{Id: 10, Name: "group10"}}, list[1].Groups)
+	})
+	t.Run("should not query if no keys", func(t *testing.T) {
+		f, _ := setup(t)
+		list := []*preloadUser{nil}
+		err := Preload(ctx, f, list, "GroupUsers", &Relation{Kind: HasMany, LocalKey: "id", ForeignKey: "user_id"})
+		assert.NoError(t, err)
+	})
+	t.Run("should propagate query error", func(t *testing.T) {
+		f, mock := setup(t)
+		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("err"))
+		err := Preload(ctx, f, users(), "GroupUsers", &Relation{Kind: HasMany, LocalKey: "id", ForeignKey: "user_id"})
+		assert.EqualError(t, err, "err")
+	})
+	t.Run("should propagate query error for many to many", func(t *testing.T) {
+		f, mock := setup(t)
+		mock.ExpectQuery("SELECT").WillReturnError(fmt.Errorf("err"))
+		err := Preload(ctx, f, users(), "Groups", &Relation{
+			Kind: ManyToMany, LocalKey: "id",
+			Through: "group_users", ForeignKey: "user_id", ThroughKey: "group_id", TargetKey: "id",
+		})
+		assert.EqualError(t, err, "err")
+	})
+	t.Run("should return error if arguments are invalid", func(t *testing.T) {
+		f, _ := setup(t)
+		hasMany := &Relation{Kind: HasMany, LocalKey: "id", ForeignKey: "user_id"}
+		assert.EqualError(t, Preload(ctx, f, users(), "GroupUsers", nil), "nil relation")
+		assert.ErrorIs(t, Preload(ctx, f, nil, "GroupUsers", hasMany), errPreloadParents)
+		assert.ErrorIs(t, Preload(ctx, f, []preloadUser{}, "GroupUsers", hasMany), errPreloadParents)
+		assert.EqualError(t, Preload(ctx, f, users(), "Unknown", hasMany), "field not found: Unknown")
+		assert.EqualError(t, Preload(ctx, f, users(), "Latest", hasMany), "field must be a slice of pointer of struct: *model.UserLoginHistories")
+		assert.EqualError(t, Preload(ctx, f, users(), "Groups", &Relation{Kind: BelongsTo, LocalKey: "id"}), "field must be a pointer of struct: []*model.UserGroups")
+		assert.EqualError(t, Preload(ctx, f, users(), "Groups", &Relation{LocalKey: "id"}), "unknown relation kind: 0")
+		assert.EqualError(t, Preload(ctx, f, users(), "GroupUsers", &Relation{Kind: HasMany, LocalKey: "unknown"}), "column not found in exql.preloadUser: unknown")
+		assert.EqualError(t, Preload(ctx, f, users(), "GroupUsers", &Relation{Kind: HasMany, LocalKey: "id", ForeignKey: "unknown"}), "column not found in model.GroupUsers: unknown")
+		assert.EqualError(t, Preload(ctx, f, users(), "Groups", &Relation{Kind: ManyToMany, LocalKey: "id"}), "through, through key and target key are required for many-to-many relation")
+		type noModel struct {
+			Id int64 `exql:"column:id"`
+		}
+		type parent struct {
+			Id       int64      `exql:"column:id"`
+			Children []*noModel `exql:"has_many:children"`
+		}
+		assert.EqualError(t, Preload(ctx, f, []*parent{{Id: 1}}, "Children", &Relation{Kind: HasMany, LocalKey: "id"}), "empty table for relation")
+	})
+}
+
+func TestRelationKey(t *testing.T) {
+	assertKey := func(v any, expected any) {
+		key, ok := relationKey(reflect.ValueOf(v))
+		assert.True(t, ok)
+		assert.Equal(t, expected, key)
+	}
+	assertKey(int32(1), int64(1))
+	assertKey(uint64(1), int64(1))
+	assertKey(uint64(1<<63), uint64(1<<63))
+	assertKey("a", "a")
+	assertKey([]byte("a"), "a")
+	assertKey(null.New[int64](1), int64(1))
+	assertKey(1.5, 1.5)
+	_, ok := relationKey(reflect.ValueOf(null.Int64{}))
+	assert.False(t, ok)
+}
@@ -0,0 +1,183 @@
+package exql
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	q "github.com/loilo-inc/exql/v3/query"
+)
+
+// QualifiedColumnSeparator separates the table name and the column name in qualified column aliases.
+const QualifiedColumnSeparator = "__"
+
+type qualifiedMapper struct{}
+
+// NewQualifiedMapper returns a SerialMapper that assigns each column to the destination
+// whose TableName() matches the table part of the column alias ("table__column").
+// Unlike the mapper made by NewSerialMapper, it doesn't depend on the order of columns.
+// Columns qualified by none of the destination tables are ignored, but it returns an error
+// if a column qualified by the destination table has no field or a destination has no columns.
+// Destinations MUST implement Model.
+//
+// Example:
+//
+//	rows, err := db.Query(query.New(
+//		`SELECT :?, :? FROM users JOIN user_groups ...`,
+//		exql.MustQualifiedColumns(&model.Users{}),
+//		exql.MustQualifiedColumns(&model.UserGroups{}),
+//	))
+//	var user model.Users
+//	var group *model.UserGroups
+//	err := m.Map(rows, &user, &group)
+func NewQualifiedMapper() SerialMapper {
+	return &qualifiedMapper{}
+}
+
+// QualifiedColumns makes a select list of the model's columns aliased as "table__column".
+func QualifiedColumns(modelPtr Model) (q.Query, error) {
+	if modelPtr == nil {
+		return nil, errModelNil
+	}
+	dest, err := resolveDestination(modelPtr)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := parseMapSchema(dest.Type())
+	if err != nil {
+		return nil, err
+	}
+	return q.QualifiedCols(modelPtr.TableName(), schema.columns()...), nil
+}
+
+// MustQualifiedColumns is same as QualifiedColumns but panics if an error occurred.
+func MustQualifiedColumns(modelPtr Model) q.Query {
+	cols, err := QualifiedColumns(modelPtr)
+	if err != nil {
+		panic(err)
+	}
+	return cols
+}
+
+func (m *qualifiedMapper) Map(
+	rows SqlRows,
+	dest ...any,
+) error {
+	var values []*nullableDest
+
+	if len(dest) == 0 {
+		return fmt.Errorf("empty dest list")
+	}
+
+	for _, model := range dest {
+		destValue, err := resolveNullableDestination(model)
+		if err != nil {
+			return err
+		}
+		values = append(values, destValue)
+	}
+	return mapQualifiedRows(rows, values)
+}
+
+var errQualifiedDestination = fmt.Errorf("destination must implement exql.Model")
+
+func mapQualifiedRows(
+	row SqlRows,
+	destList []*nullableDest,
+) error {
+	destIndexes := map[string]int{}
+	var destTables []string
+	var destFields []map[string]int
+	// Model values to be filled. For *Model destinations, they are allocated newly.
+	var models []reflect.Value
+	for destIndex, dest := range destList {
+		m, ok := reflect.New(dest.elemType).Interface().(Model)
+		if !ok {
+			return errQualifiedDestination
+		}
+		table := m.TableName()
+		if _, ok := destIndexes[table]; ok {
+			return fmt.Errorf("duplicate destination table: %s", table)
+		}
+		destIndexes[table] = destIndex
+		destTables = append(destTables, table)
+		md, err := parseMapSchema(dest.elemType)
+		if err != nil {
+			return err
+		}
+		destFields = append(destFields, md.fields)
+		if dest.value.Kind() == reflect.Struct {
+			models = append(models, *dest.value)
+		} else {
+			models = append(models, reflect.New(dest.elemType).Elem())
+		}
+	}
+	cols, err := row.Columns()
+	if err != nil {
+		return err
+	}
+	destVals := make([]any, len(cols))
+	// Field indexes of columns, and column indexes for each nullable destination
+	colFields := make([]int, len(cols))
+	nullableCols := map[int][]int{}
+	mapped := make([]bool, len(destList))
+	ns := &noopScanner{}
+	for colIndex, col := range cols {
+		destVals[colIndex] = ns
+		destIndex, name := qualifiedDestination(destTables, col)
+		if destIndex < 0 {
+			continue
+		}
+		fIndex, ok := destFields[destIndex][name]
+		if !ok {
+			return fmt.Errorf("no field for column: %s", col)
+		}
+		colFields[colIndex] = fIndex
+		mapped[destIndex] = true
+		f := models[destIndex].Field(fIndex)
+		if destList[destIndex].value.Kind() == reflect.Struct {
+			destVals[colIndex] = f.Addr().Interface() // *(Model.Field)
+		} else {
+			destVals[colIndex] = reflect.New(f.Addr().Type()).Interface() // **(Model.Field)
+			nullableCols[destIndex] = append(nullableCols[destIndex], colIndex)
+		}
+	}
+	for destIndex, ok := range mapped {
+		if !ok {
+			return fmt.Errorf("no columns for destination table: %s", destTables[destIndex])
+		}
+	}
+	if err := row.Scan(destVals...); err != nil {
+		return err
+	}
+	for destIndex, colIndexes := range nullableCols {
+		model := models[destIndex]
+		isNull := true
+		for _, colIndex := range colIndexes {
+			v := reflect.ValueOf(destVals[colIndex]).Elem() // *(Model.Field)
+			if v.IsNil() {
+				continue
+			}
+			isNull = false
+			model.Field(colFields[colIndex]).Set(v.Elem())
+		}
+		if !isNull {
+			destList[destIndex].value.Set(model.Addr()) // dest = *Model
+		}
+	}
+	return nil
+}
+
+// qualifiedDestination returns the index of the destination table qualifying col and the column name,
+// or -1 if none of tables qualifies it. Table names are matched as a whole, as they may contain
+// the separator, and the longest one is preferred.
+func qualifiedDestination(tables []string, col string) (int, string) {
+	destIndex, name := -1, ""
+	for i, table := range tables {
+		rest, ok := strings.CutPrefix(col, table+QualifiedColumnSeparator)
+		if ok && (destIndex < 0 || len(table) > len(tables[destIndex])) {
+			destIndex, name = i, rest
+		}
+	}
+	return destIndex, name
+}
@@ -0,0 +1,171 @@
+package exql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/internal/mock"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/model/testmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+type legacyUsers struct {
+	Id   int64  `exql:"column:id;primary"`
+	Name string `exql:"column:name"`
+}
+
+func (legacyUsers) TableName() string {
+	return "users__legacy"
+}
+
+func TestQualifiedMapper_Map(t *testing.T) {
+	m := NewQualifiedMapper()
+	query := func(t *testing.T, rows *sqlmock.Rows) SqlRows {
+		mockDb, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		t.Cleanup(func() { mockDb.Close() })
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		res, err := mockDb.Query("SELECT")
+		assert.NoError(t, err)
+		assert.True(t, res.Next())
+		return res
+	}
+	t.Run("basic", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{
+			"user_groups__name", "users__id", "user_groups__id", "users__name", "users__age",
+		}).AddRow("group1", 1, 2, "user1", 10))
+		var user model.Users
+		var group model.UserGroups
+		err := m.Map(rows, &user, &group)
+		assert.NoError(t, err)
+		assert.Equal(t, model.Users{Id: 1, Name: "user1", Age: 10}, user)
+		assert.Equal(t, model.UserGroups{Id: 2, Name: "group1"}, group)
+	})
+	t.Run("same head columns", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{
+			"group_users__id", "group_users__user_id", "group_users__group_id", "users__id", "users__name",
+		}).AddRow(3, 1, 2, 1, "user1"))
+		var member model.GroupUsers
+		var user model.Users
+		err := m.Map(rows, &user, &member)
+		assert.NoError(t, err)
+		assert.Equal(t, model.Users{Id: 1, Name: "user1"}, user)
+		assert.Equal(t, model.GroupUsers{Id: 3, UserId: 1, GroupId: 2}, member)
+	})
+	t.Run("outer join", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{
+			"users__id", "users__name", "user_groups__id", "user_groups__name",
+		}).AddRow(1, "user1", nil, nil))
+		var user model.Users
+		var group *model.UserGroups
+		err := m.Map(rows, &user, &group)
+		assert.NoError(t, err)
+		assert.Equal(t, model.Users{Id: 1, Name: "user1"}, user)
+		assert.Nil(t, group)
+	})
+	t.Run("*struct", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{
+			"users__id", "users__name", "user_groups__id", "user_groups__name",
+		}).AddRow(1, "user1", 2, "group1"))
+		var user *model.Users
+		var group *model.UserGroups
+		err := m.Map(rows, &user, &group)
+		assert.NoError(t, err)
+		assert.Equal(t, &model.Users{Id: 1, Name: "user1"}, user)
+		assert.Equal(t, &model.UserGroups{Id: 2, Name: "group1"}, group)
+	})
+	t.Run("table names with the separator", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{
+			"users__legacy__id", "users__id", "users__legacy__name", "users__name",
+		}).AddRow(2, 1, "legacy1", "user1"))
+		var user model.Users
+		var legacy legacyUsers
+		err := m.Map(rows, &user, &legacy)
+		assert.NoError(t, err)
+		assert.Equal(t, model.Users{Id: 1, Name: "user1"}, user)
+		assert.Equal(t, legacyUsers{Id: 2, Name: "legacy1"}, legacy)
+	})
+	t.Run("should ignore columns of other tables", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{
+			"id", "users__id", "groups__id",
+		}).AddRow(9, 1, 2))
+		var user model.Users
+		err := m.Map(rows, &user)
+		assert.NoError(t, err)
+		assert.Equal(t, model.Users{Id: 1}, user)
+	})
+	t.Run("should return error if qualified column has no field", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{"users__id", "users__unknown"}).AddRow(1, "x"))
+		var user model.Users
+		err := m.Map(rows, &user)
+		assert.EqualError(t, err, "no field for column: users__unknown")
+	})
+	t.Run("should return error if destination has no columns", func(t *testing.T) {
+		rows := query(t, sqlmock.NewRows([]string{"users__id", "groups__id"}).AddRow(1, 2))
+		var user model.Users
+		var group *model.UserGroups
+		err := m.Map(rows, &user, &group)
+		assert.EqualError(t, err, "no columns for destination table: user_groups")
+	})
+	t.Run("should return error if dest is empty", func(t *testing.T) {
+		err := m.Map(nil)
+		assert.EqualError(t, err, "empty dest list")
+	})
+	t.Run("should return error if destination is invalid", func(t *testing.T) {
+		i := 0
+		assert.Equal(t, errMapRowSerialDestination, m.Map(nil, &i))
+	})
+	t.Run("should return error if destination is not a model", func(t *testing.T) {
+		var p partialUser
+		assert.Equal(t, errQualifiedDestination, m.Map(nil, &p))
+	})
+	t.Run("should return error if destination tables are duplicated", func(t *testing.T) {
+		var user1, user2 model.Users
+		assert.EqualError(t, m.Map(nil, &user1, &user2), "duplicate destination table: users")
+	})
+	t.Run("should return error if parseMapSchema return error", func(t *testing.T) {
+		err := m.Map(nil, &testmodel.BadTag{})
+		assert.EqualError(t, err, "duplicated tag: a")
+	})
+	t.Run("should return error if rows.Columns() errors", func(t *testing.T) {
+		var user model.Users
+		err := m.Map(&mock.Rows{ColumnErr: fmt.Errorf("error")}, &user)
+		assert.EqualError(t, err, "error")
+	})
+	t.Run("should return error if rows.Scan() errors", func(t *testing.T) {
+		var user model.Users
+		err := m.Map(&mock.Rows{Cols: []string{"users__id"}, ScanErr: fmt.Errorf("error")}, &user)
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestQualifiedColumns(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		cols, err := QualifiedColumns(&model.Users{})
+		assert.NoError(t, err)
+		stmt, args, err := cols.Query()
+		assert.NoError(t, err)
+		assert.Equal(t, "`users`.`id` AS `users__id`,`users`.`name` AS `users__name`,`users`.`age` AS `users__age`", stmt)
+		assert.Empty(t, args)
+	})
+	t.Run("should return error if model is nil", func(t *testing.T) {
+		_, err := QualifiedColumns(nil)
+		assert.ErrorIs(t, err, errModelNil)
+	})
+	t.Run("should return error if model is not pointer", func(t *testing.T) {
+		_, err := QualifiedColumns(testmodel.BadTableName{})
+		assert.ErrorIs(t, err, errMapDestination)
+	})
+	t.Run("should return error if parseMapSchema return error", func(t *testing.T) {
+		_, err := QualifiedColumns(&testmodel.BadTag{})
+		assert.EqualError(t, err, "duplicated tag: a")
+	})
+	t.Run("MustQualifiedColumns should panic on error", func(t *testing.T) {
+		assert.NotNil(t, MustQualifiedColumns(&model.Users{}))
+		assert.Panics(t, func() {
+			MustQualifiedColumns(nil)
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/loilo-inc/exql/v3/meta"
 	q "github.com/loilo-inc/exql/v3/query"
 )
 
@@ -11,6 +12,24 @@ func Where(str string, args ...any) q.Condition {
 	return q.Cond(str, args...)
 }
 
+// ErrReadOnlyModel is returned when the read-only model, such as the model of a view, is written.
+type ErrReadOnlyModel struct {
+	TableName string
+}
+
+func (e ErrReadOnlyModel) Error() string {
+	return fmt.Sprintf("model of %s is read-only", e.TableName)
+}
+
+// checkWritable returns ErrReadOnlyModel if the metadata of the model or the update struct is marked as read-only.
+// Only ones providing meta.Provider, such as generated models, can be read-only.
+func checkWritable(modelPtr any, table string) error {
+	if p, ok := modelPtr.(meta.Provider); ok && p.TableMetadata().ReadOnly {
+		return ErrReadOnlyModel{TableName: table}
+	}
+	return nil
+}
+
 func QueryForInsert(modelPtr Model) (q.Query, *reflect.Value, error) {
 	dest, err := resolveDestination(modelPtr)
 	if err != nil {
@@ -24,6 +43,9 @@ func QueryForInsert(modelPtr Model) (q.Query, *reflect.Value, error) {
 	if tableName == "" {
 		return nil, nil, errTableNameEmpty
 	}
+	if err := checkWritable(modelPtr, tableName); err != nil {
+		return nil, nil, err
+	}
 	v, err := ms.aggregateValue(modelPtr)
 	if err != nil {
 		return nil, nil, err
@@ -53,6 +75,9 @@ func QueryForBulkInsert[T Model](modelPtrs ...T) (q.Query, error) {
 	if tableName == "" {
 		return nil, errTableNameEmpty
 	}
+	if err := checkWritable(modelPtrs[0], tableName); err != nil {
+		return nil, err
+	}
 	var cols q.Query
 	b := q.NewBuilder()
 	vals := q.NewBuilder()
@@ -91,6 +116,9 @@ func QueryForUpdateModel(
 	if tableName == "" {
 		return nil, errTableNameEmpty
 	}
+	if err := checkWritable(updateStructPtr, tableName); err != nil {
+		return nil, err
+	}
 	v, err := ms.aggregateValue(updateStructPtr)
 	if err != nil {
 		return nil, err
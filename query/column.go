@@ -0,0 +1,107 @@
+package query
+
+// Column is the typed handle of a table column for building conditions.
+// Values compared with the column must be of T, the Go type of the model field,
+// so that mismatched types are rejected by the compiler.
+//
+// Example:
+//
+//	age := NewColumn[int64]("users", "age")
+//	age.Gt(20) // `users`.`age` > ? -> query | [20] -> arguments
+type Column[T any] struct {
+	table string
+	name  string
+}
+
+// NewColumn returns the handle of the column in the table.
+func NewColumn[T any](table, name string) Column[T] {
+	return Column[T]{table: table, name: name}
+}
+
+// Table returns the table name of the column.
+func (c Column[T]) Table() string {
+	return c.table
+}
+
+// Name returns the column name.
+func (c Column[T]) Name() string {
+	return c.name
+}
+
+// Query implements Query. It returns the column name qualified with the table name.
+func (c Column[T]) Query() (string, []any, error) {
+	if c.table == "" {
+		return Cols(c.name).Query()
+	}
+	return Cols(c.table + "." + c.name).Query()
+}
+
+// Eq makes "column = ?".
+func (c Column[T]) Eq(v T) Condition {
+	return Cond(":? = ?", c, v)
+}
+
+// Ne makes "column <> ?".
+func (c Column[T]) Ne(v T) Condition {
+	return Cond(":? <> ?", c, v)
+}
+
+// Gt makes "column > ?".
+func (c Column[T]) Gt(v T) Condition {
+	return Cond(":? > ?", c, v)
+}
+
+// Gte makes "column >= ?".
+func (c Column[T]) Gte(v T) Condition {
+	return Cond(":? >= ?", c, v)
+}
+
+// Lt makes "column < ?".
+func (c Column[T]) Lt(v T) Condition {
+	return Cond(":? < ?", c, v)
+}
+
+// Lte makes "column <= ?".
+func (c Column[T]) Lte(v T) Condition {
+	return Cond(":? <= ?", c, v)
+}
+
+// Between makes "column BETWEEN ? AND ?".
+func (c Column[T]) Between(from, to T) Condition {
+	return Cond(":? BETWEEN ? AND ?", c, from, to)
+}
+
+// In makes "column IN (?,...)". It results in an error if vs is empty.
+func (c Column[T]) In(vs ...T) Condition {
+	return Cond(":? IN (:?)", c, Vals(vs))
+}
+
+// NotIn makes "column NOT IN (?,...)". It results in an error if vs is empty.
+func (c Column[T]) NotIn(vs ...T) Condition {
+	return Cond(":? NOT IN (:?)", c, Vals(vs))
+}
+
+// Like makes "column LIKE ?". The pattern is not escaped.
+func (c Column[T]) Like(pattern string) Condition {
+	return Cond(":? LIKE ?", c, pattern)
+}
+
+// IsNull makes "column IS NULL".
+func (c Column[T]) IsNull() Condition {
+	return Cond(":? IS NULL", c)
+}
+
+// IsNotNull makes "column IS NOT NULL".
+func (c Column[T]) IsNotNull() Condition {
+	return Cond(":? IS NOT NULL", c)
+}
+
+// Asc makes "column ASC" for ORDER BY clauses.
+func (c Column[T]) Asc() Query {
+	return New(":? ASC", c)
+}
+
+// Desc makes "column DESC" for ORDER BY clauses.
+func (c Column[T]) Desc() Query {
+	return New(":? DESC", c)
+}
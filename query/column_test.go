@@ -0,0 +1,50 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/loilo-inc/exql/v3/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumn(t *testing.T) {
+	age := query.NewColumn[int64]("users", "age")
+	name := query.NewColumn[string]("", "name")
+	t.Run("accessors", func(t *testing.T) {
+		assert.Equal(t, "users", age.Table())
+		assert.Equal(t, "age", age.Name())
+	})
+	t.Run("Query", func(t *testing.T) {
+		assertQuery(t, age, "`users`.`age`")
+		assertQuery(t, name, "`name`")
+	})
+	t.Run("comparisons", func(t *testing.T) {
+		assertQuery(t, age.Eq(1), "`users`.`age` = ?", int64(1))
+		assertQuery(t, age.Ne(1), "`users`.`age` <> ?", int64(1))
+		assertQuery(t, age.Gt(1), "`users`.`age` > ?", int64(1))
+		assertQuery(t, age.Gte(1), "`users`.`age` >= ?", int64(1))
+		assertQuery(t, age.Lt(1), "`users`.`age` < ?", int64(1))
+		assertQuery(t, age.Lte(1), "`users`.`age` <= ?", int64(1))
+		assertQuery(t, age.Between(1, 2), "`users`.`age` BETWEEN ? AND ?", int64(1), int64(2))
+		assertQuery(t, name.Like("a%"), "`name` LIKE ?", "a%")
+	})
+	t.Run("In", func(t *testing.T) {
+		assertQuery(t, age.In(1, 2), "`users`.`age` IN (?,?)", int64(1), int64(2))
+		assertQuery(t, age.NotIn(1), "`users`.`age` NOT IN (?)", int64(1))
+		assertQueryErr(t, age.In(), "empty values")
+		assertQueryErr(t, age.NotIn(), "empty values")
+	})
+	t.Run("null", func(t *testing.T) {
+		assertQuery(t, age.IsNull(), "`users`.`age` IS NULL")
+		assertQuery(t, age.IsNotNull(), "`users`.`age` IS NOT NULL")
+	})
+	t.Run("order", func(t *testing.T) {
+		assertQuery(t, age.Asc(), "`users`.`age` ASC")
+		assertQuery(t, age.Desc(), "`users`.`age` DESC")
+	})
+	t.Run("conditions", func(t *testing.T) {
+		cond := age.Gt(20)
+		cond.AndCond(name.Eq("go"))
+		assertQuery(t, cond, "`users`.`age` > ? AND (`name` = ?)", int64(20), "go")
+	})
+}
@@ -0,0 +1,99 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Key is the handle of the columns of a key, such as a composite primary key,
+// for building conditions of tuples. Values are given in the order of columns.
+//
+// Example:
+//
+//	key := NewKey("histories", "id", "created_at")
+//	key.Eq(1, t) // (`histories`.`id` = ? AND `histories`.`created_at` = ?)
+//	key.In([]any{1, t}, []any{2, t}) // (`histories`.`id`,`histories`.`created_at`) IN ((?,?),(?,?))
+type Key struct {
+	table   string
+	columns []string
+}
+
+// NewKey returns the handle of the key of the columns in the table.
+func NewKey(table string, columns ...string) Key {
+	return Key{table: table, columns: columns}
+}
+
+// Table returns the table name of the key.
+func (k Key) Table() string {
+	return k.table
+}
+
+// Columns returns the column names of the key in the key order.
+func (k Key) Columns() []string {
+	return k.columns
+}
+
+// Query implements Query. It returns the column names of the key qualified with the table name.
+func (k Key) Query() (string, []any, error) {
+	if len(k.columns) == 0 {
+		return "", nil, fmt.Errorf("empty columns")
+	}
+	cols := make([]string, len(k.columns))
+	for i, c := range k.columns {
+		cols[i] = c
+		if k.table != "" {
+			cols[i] = k.table + "." + c
+		}
+	}
+	return Cols(cols...).Query()
+}
+
+// Eq makes "column = ?" for a single column and "(a = ? AND b = ?)" for columns.
+// It results in an error if the number of values doesn't match the columns.
+func (k Key) Eq(vals ...any) Condition {
+	if err := k.validate(vals); err != nil {
+		return CondFrom(errQuery(err))
+	}
+	var list []string
+	var args []any
+	for i, c := range k.columns {
+		list = append(list, ":? = ?")
+		args = append(args, NewKey(k.table, c), vals[i])
+	}
+	if len(list) == 1 {
+		return Cond(list[0], args...)
+	}
+	return Cond("("+strings.Join(list, " AND ")+")", args...)
+}
+
+// In makes "column IN (?,...)" for a single column and "(a,b) IN ((?,?),...)" for columns.
+// It results in an error if tuples is empty or the number of values of any doesn't match the columns.
+func (k Key) In(tuples ...[]any) Condition {
+	if len(tuples) == 0 {
+		return CondFrom(errQuery(fmt.Errorf("empty values")))
+	}
+	b := NewBuilder()
+	for _, vals := range tuples {
+		if err := k.validate(vals); err != nil {
+			return CondFrom(errQuery(err))
+		}
+		if len(k.columns) == 1 {
+			b.Query(":?", V(vals...))
+		} else {
+			b.Query("(:?)", V(vals...))
+		}
+	}
+	if len(k.columns) == 1 {
+		return Cond(":? IN (:?)", k, b.Join(","))
+	}
+	return Cond("(:?) IN (:?)", k, b.Join(","))
+}
+
+func (k Key) validate(vals []any) error {
+	if len(k.columns) == 0 {
+		return fmt.Errorf("empty columns")
+	} else if len(vals) != len(k.columns) {
+		return fmt.Errorf("key has %d columns but %d values are given", len(k.columns), len(vals))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/loilo-inc/exql/v3/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKey(t *testing.T) {
+	key := query.NewKey("histories", "id", "created_at")
+	id := query.NewKey("", "id")
+	t.Run("accessors", func(t *testing.T) {
+		assert.Equal(t, "histories", key.Table())
+		assert.Equal(t, []string{"id", "created_at"}, key.Columns())
+	})
+	t.Run("Query", func(t *testing.T) {
+		assertQuery(t, key, "`histories`.`id`,`histories`.`created_at`")
+		assertQuery(t, id, "`id`")
+		assertQueryErr(t, query.NewKey("histories"), "empty columns")
+	})
+	t.Run("Eq", func(t *testing.T) {
+		assertQuery(t, key.Eq(1, "t"), "(`histories`.`id` = ? AND `histories`.`created_at` = ?)", 1, "t")
+		assertQuery(t, id.Eq(1), "`id` = ?", 1)
+		assertQueryErr(t, key.Eq(1), "key has 2 columns but 1 values are given")
+		assertQueryErr(t, query.NewKey("histories").Eq(), "empty columns")
+	})
+	t.Run("In", func(t *testing.T) {
+		stmt, args, err := key.In([]any{1, "t1"}, []any{2, "t2"}).Query()
+		assert.NoError(t, err)
+		assert.Equal(t, "(`histories`.`id`,`histories`.`created_at`) IN ((?,?),(?,?))", stmt)
+		assert.Equal(t, []any{1, "t1", 2, "t2"}, args)
+		assertQuery(t, id.In([]any{1}, []any{2}), "`id` IN (?,?)", 1, 2)
+		assertQueryErr(t, key.In(), "empty values")
+		assertQueryErr(t, key.In([]any{1, "t1"}, []any{2}), "key has 2 columns but 1 values are given")
+	})
+	t.Run("combined", func(t *testing.T) {
+		cond := key.Eq(1, "t")
+		cond.And("deleted = ?", false)
+		assertQuery(t, cond, "(`histories`.`id` = ? AND `histories`.`created_at` = ?) AND deleted = ?", 1, "t", false)
+	})
+}
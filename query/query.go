@@ -22,6 +22,13 @@ func errQuery(err error) Query {
 	return &query{err: err}
 }
 
+// Err returns the query resulting in err, to defer errors until the query is built.
+//
+//	cond := query.CondFrom(query.Err(err)) // err is returned by Query() of cond
+func Err(err error) Query {
+	return errQuery(err)
+}
+
 func (f *query) Query() (sqlStmt string, sqlArgs []any, resErr error) {
 	if f.err != nil {
 		resErr = f.err
@@ -209,6 +216,31 @@ func Cols(cols ...string) Query {
 	}
 }
 
+// QualifiedCols makes a select list of the given table's columns,
+// aliasing each of them as "table__column" so that joined rows can be
+// assigned to destinations by table name.
+//
+// Example:
+//
+//	QualifiedCols("users", "id", "name") // `users`.`id` AS `users__id`,`users`.`name` AS `users__name`
+func QualifiedCols(table string, cols ...string) Query {
+	if table == "" {
+		return errQuery(fmt.Errorf("empty table"))
+	} else if len(cols) == 0 {
+		return errQuery(fmt.Errorf("empty columns"))
+	}
+	var list []string
+	for _, col := range cols {
+		list = append(list, fmt.Sprintf("%s AS %s",
+			QuoteColumn(table+"."+col),
+			QuoteColumn(table+"__"+col),
+		))
+	}
+	return &query{
+		query: strings.Join(list, ","),
+	}
+}
+
 // V wraps one or more values for the prepared statement.
 // It counts number of values and interpolates Go's SQL placeholder(?), holding  values for later.
 // Multiple values will be joined by comma(,).
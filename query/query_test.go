@@ -1,6 +1,7 @@
 package query_test
 
 import (
+	"fmt"
 	"testing"
 
 	q "github.com/loilo-inc/exql/v3/query"
@@ -25,6 +26,7 @@ func TestQuery(t *testing.T) {
 	assertQuery(t, q.Vals([]int{1, 2}), "?,?", 1, 2)
 	assertQuery(t, q.Cols("a.b", "c.*"), "`a`.`b`,`c`.*")
 	assertQuery(t, q.Q("id = ?", 1), "id = ?", 1)
+	assertQuery(t, q.QualifiedCols("users", "id", "name"), "`users`.`id` AS `users__id`,`users`.`name` AS `users__name`")
 	assertQuery(t,
 		q.Set(map[string]any{
 			"a":     "a",
@@ -37,7 +39,11 @@ func TestQuery(t *testing.T) {
 	assertQueryErr(t, q.Q(""), "DANGER: empty query")
 	assertQueryErr(t, q.Vals[any](nil), "empty values")
 	assertQueryErr(t, q.Cols(), "empty columns")
+	assertQueryErr(t, q.QualifiedCols(""), "empty table")
+	assertQueryErr(t, q.QualifiedCols("users"), "empty columns")
 	assertQueryErr(t, q.Set(map[string]any{}), "empty values for set clause")
+	assertQueryErr(t, q.Err(fmt.Errorf("err")), "err")
+	assertQueryErr(t, q.New("id = ? AND :?", 1, q.CondFrom(q.Err(fmt.Errorf("err")))), "err")
 }
 
 func TestNew(t *testing.T) {
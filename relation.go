@@ -0,0 +1,131 @@
+package exql
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// ModelRelation is the relation between generated models derived from a single-column foreign key.
+type ModelRelation struct {
+	// Name is the Go name of the relation, e.g. User for group_users.user_id -> users.id
+	// and GroupUsers for the reverse.
+	Name string
+	// Kind is BelongsTo for the referencing table, and HasOne or HasMany for the referenced table.
+	Kind RelationKind
+	// Column is the column of the table having the relation.
+	Column *Column
+	// Target is the related table.
+	Target *Table
+	// TargetColumn is the column of Target matched with Column.
+	TargetColumn *Column
+}
+
+// TargetModel is the struct name of the related model.
+func (r *ModelRelation) TargetModel() string {
+	return strcase.ToCamel(r.Target.TableName)
+}
+
+// KindName is the name of the Kind constant.
+func (r *ModelRelation) KindName() string {
+	switch r.Kind {
+	case BelongsTo:
+		return "BelongsTo"
+	case HasOne:
+		return "HasOne"
+	}
+	return "HasMany"
+}
+
+// Many reports whether the relation loads multiple models.
+func (r *ModelRelation) Many() bool {
+	return r.Kind == HasMany
+}
+
+// Nullable reports whether Column is typed by null.Null, that is nothing is related if NULL.
+func (r *ModelRelation) Nullable() bool {
+	return strings.HasPrefix(r.Column.GoFieldType, "null.")
+}
+
+// linkRelations sets Relations of the tables from their single-column foreign keys.
+// Foreign keys referring tables not in the list are ignored, since their models are not generated.
+func linkRelations(tables []*Table) {
+	byName := map[string]*Table{}
+	for _, t := range tables {
+		t.Relations = nil
+		byName[t.TableName] = t
+	}
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			target := byName[fk.ReferencedTable]
+			if target == nil || len(fk.Columns) != 1 || len(fk.ReferencedColumns) != 1 {
+				continue
+			}
+			col, targetCol := t.Column(fk.Columns[0]), target.Column(fk.ReferencedColumns[0])
+			if col == nil || targetCol == nil {
+				continue
+			}
+			name := relationBaseName(col.FieldName, target.TableName)
+			t.Relations = appendRelation(t.Relations, &ModelRelation{
+				Name: name, Kind: BelongsTo, Column: col, Target: target, TargetColumn: targetCol,
+			})
+			reverseName := strcase.ToCamel(t.TableName)
+			if countForeignKeys(t, target.TableName) > 1 {
+				reverseName += "By" + columnBaseName(col.FieldName)
+			}
+			kind := HasMany
+			if t.isUniqueColumn(col.FieldName) {
+				kind = HasOne
+			}
+			target.Relations = appendRelation(target.Relations, &ModelRelation{
+				Name: reverseName, Kind: kind, Column: targetCol, Target: t, TargetColumn: col,
+			})
+		}
+	}
+}
+
+// relationBaseName names the relation by the column without "_id" suffix, e.g. User for user_id,
+// or by the target table if the column has no suffix.
+func relationBaseName(column, target string) string {
+	if strings.HasSuffix(strings.ToLower(column), "_id") && len(column) > 3 {
+		return columnBaseName(column)
+	}
+	return strcase.ToCamel(target) + "By" + columnBaseName(column)
+}
+
+// columnBaseName is the column name in camel case without "_id" suffix.
+func columnBaseName(column string) string {
+	if name, ok := strings.CutSuffix(strings.ToLower(column), "_id"); ok && name != "" {
+		return strcase.ToCamel(column[:len(name)])
+	}
+	return strcase.ToCamel(column)
+}
+
+func countForeignKeys(t *Table, target string) int {
+	n := 0
+	for _, fk := range t.ForeignKeys {
+		if fk.ReferencedTable == target && len(fk.Columns) == 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// appendRelation appends r skipping the duplicate name, which can't be generated twice.
+func appendRelation(list []*ModelRelation, r *ModelRelation) []*ModelRelation {
+	if slices.ContainsFunc(list, func(e *ModelRelation) bool { return e.Name == r.Name }) {
+		return list
+	}
+	return append(list, r)
+}
+
+// isUniqueColumn reports whether the column alone is the primary or a unique key.
+func (t *Table) isUniqueColumn(name string) bool {
+	for _, idx := range t.Indexes {
+		if idx.Unique && len(idx.Columns) == 1 && idx.Columns[0] == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package exql
+
+import (
+	"context"
+	"database/sql"
+	"go/format"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkRelations(t *testing.T) {
+	pk := sql.NullString{String: "PRI", Valid: true}
+	users := &Table{
+		TableName: "users",
+		Columns: []*Column{
+			{FieldName: "id", GoFieldType: "int64", Key: pk},
+		},
+	}
+	profiles := &Table{
+		TableName: "profiles",
+		Columns: []*Column{
+			{FieldName: "id", GoFieldType: "int64", Key: pk},
+			{FieldName: "user_id", GoFieldType: "int64"},
+		},
+		Indexes: []*Index{{Name: "user_id", Columns: []string{"user_id"}, Unique: true}},
+		ForeignKeys: []*ForeignKey{
+			{Name: "fk1", Columns: []string{"user_id"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+		},
+	}
+	messages := &Table{
+		TableName: "messages",
+		Columns: []*Column{
+			{FieldName: "id", GoFieldType: "int64", Key: pk},
+			{FieldName: "sender_id", GoFieldType: "int64"},
+			{FieldName: "receiver_id", GoFieldType: "null.Int64", Nullable: true},
+			{FieldName: "owner", GoFieldType: "int64"},
+			{FieldName: "group_id", GoFieldType: "int64"},
+		},
+		ForeignKeys: []*ForeignKey{
+			{Name: "fk1", Columns: []string{"sender_id"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+			{Name: "fk2", Columns: []string{"receiver_id"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+			{Name: "fk3", Columns: []string{"owner"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+			{Name: "fk4", Columns: []string{"group_id"}, ReferencedTable: "groups", ReferencedColumns: []string{"id"}},
+			{Name: "fk5", Columns: []string{"id", "sender_id"}, ReferencedTable: "users", ReferencedColumns: []string{"id", "x"}},
+			{Name: "fk6", Columns: []string{"unknown"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+		},
+	}
+	linkRelations([]*Table{users, profiles, messages})
+
+	type rel struct {
+		name, kind, column, target, targetColumn string
+		nullable                                 bool
+	}
+	relations := func(table *Table) []rel {
+		var ret []rel
+		for _, r := range table.Relations {
+			ret = append(ret, rel{r.Name, r.KindName(), r.Column.FieldName, r.TargetModel(), r.TargetColumn.FieldName, r.Nullable()})
+		}
+		return ret
+	}
+	assert.Equal(t, []rel{
+		{"User", "BelongsTo", "user_id", "Users", "id", false},
+	}, relations(profiles))
+	assert.Equal(t, []rel{
+		{"Sender", "BelongsTo", "sender_id", "Users", "id", false},
+		{"Receiver", "BelongsTo", "receiver_id", "Users", "id", true},
+		{"UsersByOwner", "BelongsTo", "owner", "Users", "id", false},
+	}, relations(messages))
+	assert.Equal(t, []rel{
+		{"Profiles", "HasOne", "id", "Profiles", "user_id", false},
+		{"MessagesBySender", "HasMany", "id", "Messages", "sender_id", false},
+		{"MessagesByReceiver", "HasMany", "id", "Messages", "receiver_id", false},
+		{"MessagesByOwner", "HasMany", "id", "Messages", "owner", false},
+	}, relations(users))
+	assert.True(t, users.Relations[1].Many())
+	assert.False(t, users.Relations[0].Many())
+
+	file, err := messages.GenerateModelFile("dist")
+	assert.NoError(t, err)
+	fmted, err := format.Source(file.Source)
+	assert.NoError(t, err)
+	source := string(fmted)
+	assert.Contains(t, source, `import "context"`)
+	assert.Contains(t, source, `import "github.com/loilo-inc/exql/v3/iface"`)
+	assert.Contains(t, source, "func (m *Messages) LoadReceiver(ctx context.Context, finder iface.Finder) (*Users, error) {\n\tif !m.ReceiverId.Valid {\n\t\treturn nil, nil\n\t}")
+}
+
+func TestGeneratedRelations(t *testing.T) {
+	t.Run("belongs to", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery("SELECT \\* FROM `users` WHERE `id` = \\?").
+			WithArgs(int64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}).AddRow(2, "go", 10))
+		user, err := (&model.GroupUsers{UserId: 2}).LoadUser(context.Background(), NewFinder(db))
+		assert.NoError(t, err)
+		assert.Equal(t, &model.Users{Id: 2, Name: "go", Age: 10}, user)
+	})
+	t.Run("has many", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery("SELECT \\* FROM `group_users` WHERE `user_id` = \\?").
+			WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "group_id"}))
+		groupUsers, err := (&model.Users{Id: 1}).LoadGroupUsers(context.Background(), NewFinder(db))
+		assert.NoError(t, err)
+		assert.Empty(t, groupUsers)
+		assert.NotNil(t, groupUsers)
+	})
+	t.Run("preload", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery("SELECT \\* FROM `users` WHERE `id` IN \\(\\?\\)").
+			WithArgs(int64(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}).AddRow(2, "go", 10))
+		type groupUserWithUser struct {
+			model.GroupUsers
+			User *model.Users
+		}
+		parents := []*groupUserWithUser{{GroupUsers: model.GroupUsers{Id: 1, UserId: 2}}}
+		err = Preload(context.Background(), NewFinder(db), parents, "User", model.GroupUsersRelations.User)
+		assert.NoError(t, err)
+		assert.Equal(t, &model.Users{Id: 2, Name: "go", Age: 10}, parents[0].User)
+	})
+}
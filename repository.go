@@ -0,0 +1,266 @@
+package exql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// repositoryFileSuffix is appended to the table name for the file name of repositories.
+const repositoryFileSuffix = "_repository"
+
+// repositoryMockgen is the command generating gomock mocks of repositories into the mock_<package> package.
+const repositoryMockgen = "go run go.uber.org/mock/mockgen@v0.6.0 -source=$GOFILE -destination=mock_$GOPACKAGE/$GOFILE -package=mock_$GOPACKAGE"
+
+var builtinRepositoryTemplate = template.Must(ParseModelTemplate("repository", repositoryTemplate))
+
+// RepositoryImports returns the import declarations required by the repository of the table.
+func (t *Table) RepositoryImports() string {
+	paths := []string{"context", "sync", "github.com/loilo-inc/exql/v3/iface"}
+	if pk := t.PrimaryKeyFinder(); pk != nil && !t.View {
+		paths = append(paths, "fmt")
+	}
+	columns := slices.Clone(t.PrimaryKeyColumns())
+	for _, f := range t.IndexFinders() {
+		columns = append(columns, f.Columns...)
+	}
+	for _, c := range columns {
+		if c.GoFieldType != timeType && c.GoFieldType != nullTimeType {
+			paths = append(paths, "reflect")
+		}
+		switch {
+		case strings.HasPrefix(c.GoFieldType, "time."):
+			paths = append(paths, "time")
+		case strings.HasPrefix(c.GoFieldType, "json."):
+			paths = append(paths, "encoding/json")
+		case strings.HasPrefix(c.GoFieldType, "decimal."):
+			paths = append(paths, "github.com/loilo-inc/exql/v3/decimal")
+		case strings.HasPrefix(c.GoFieldType, "null."):
+			paths = append(paths, "github.com/loilo-inc/exql/v3/null")
+		}
+		paths = append(paths, c.Imports...)
+	}
+	// Standard packages first, as in models
+	slices.SortFunc(paths, func(a, b string) int {
+		if aStd, bStd := !strings.Contains(a, "."), !strings.Contains(b, "."); aStd != bStd {
+			if aStd {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	var imports []string
+	for _, path := range slices.Compact(paths) {
+		imports = append(imports, fmt.Sprintf("import %q", path))
+	}
+	return strings.Join(imports, "\n")
+}
+
+const repositoryTemplate = generatedFileHeader + `
+package {{.Package}}
+
+{{.Table.RepositoryImports}}
+
+//go:generate ` + repositoryMockgen + `
+{{- /* views have no Update structs to update by the key */}}
+{{- $pk := and (not .Table.View) .Table.PrimaryKeyFinder}}
+{{- $auto := .Table.AutoIncrementColumn}}
+
+// {{.Model}}Repository is the repository of {{.Model}}.
+// New{{.Model}}Repository returns the implementation by exql, and Fake{{.Model}}Repository is
+// the in-memory implementation for tests. Mocks by gomock are generated by go generate.
+type {{.Model}}Repository interface {
+{{- if not .Table.View}}
+	// Insert inserts the model{{if $auto}} and sets {{$auto.GoName}} by auto_increment{{end}}.
+	Insert(ctx context.Context, record *{{.Model}}) error
+{{- end}}
+{{- with $pk}}
+	// Update updates the columns set in update of the model by the primary key.
+	Update(ctx context.Context, {{.Params}}, update *Update{{$.Model}}) error
+	// Delete deletes the model by the primary key.
+	Delete(ctx context.Context, {{.Params}}) error
+{{- end}}
+{{- range .Table.IndexFinders}}
+{{- if .Unique}}
+	// {{.Method}} finds the model by the {{if .Primary}}primary{{else}}unique{{end}} key. It returns exql.ErrRecordNotFound if not found.
+	{{.Method}}(ctx context.Context, {{.Params}}) (*{{$.Model}}, error)
+{{- else}}
+	// {{.Method}} finds models by the index. It returns an empty slice if not found.
+	{{.Method}}(ctx context.Context, {{.Params}}) ([]*{{$.Model}}, error)
+{{- end}}
+{{- end}}
+}
+
+type {{.ModelLower}}Repository struct {
+	db iface.SaverFinder
+}
+
+// New{{.Model}}Repository returns {{.Model}}Repository by exql.DB or exql.Tx.
+func New{{.Model}}Repository(db iface.SaverFinder) {{.Model}}Repository {
+	return &{{.ModelLower}}Repository{db: db}
+}
+
+{{- if not .Table.View}}
+
+func (r *{{.ModelLower}}Repository) Insert(ctx context.Context, record *{{.Model}}) error {
+	_, err := r.db.InsertContext(ctx, record)
+	return err
+}
+{{- end}}
+{{- with $pk}}
+
+func (r *{{$.ModelLower}}Repository) Update(ctx context.Context, {{.Params}}, update *Update{{$.Model}}) error {
+	_, err := r.db.UpdateModelContext(ctx, update, {{.KeyLiteral $.Model}}.Cond())
+	return err
+}
+
+func (r *{{$.ModelLower}}Repository) Delete(ctx context.Context, {{.Params}}) error {
+	_, err := r.db.DeleteContext(ctx, {{$.Model}}TableName, {{.KeyLiteral $.Model}}.Cond())
+	return err
+}
+{{- end}}
+{{- range .Table.IndexFinders}}
+
+func (r *{{$.ModelLower}}Repository) {{.Method}}(ctx context.Context, {{.Params}}) ({{if not .Unique}}[]{{end}}*{{$.Model}}, error) {
+	return {{.Name}}(ctx, r.db, {{.Args}})
+}
+{{- end}}
+
+// Fake{{.Model}}Repository is the in-memory {{.Model}}Repository for tests.
+// It stores copies of models, and is safe for concurrent use.
+type Fake{{.Model}}Repository struct {
+	mu      sync.Mutex
+	records []*{{.Model}}
+{{- with $auto}}
+	lastInsertId {{.GoFieldType}}
+{{- end}}
+}
+
+var _ {{.Model}}Repository = (*Fake{{.Model}}Repository)(nil)
+
+// NewFake{{.Model}}Repository returns the fake repository with the records inserted.
+// It panics if records can't be inserted.
+func NewFake{{.Model}}Repository(records ...*{{.Model}}) *Fake{{.Model}}Repository {
+	r := &Fake{{.Model}}Repository{}
+	for _, record := range records {
+{{- if .Table.View}}
+		c := *record
+		r.records = append(r.records, &c)
+{{- else}}
+		if err := r.Insert(context.Background(), record); err != nil {
+			panic(err)
+		}
+{{- end}}
+	}
+	return r
+}
+
+// Records returns copies of the stored models in the inserted order.
+func (r *Fake{{.Model}}Repository) Records() []*{{.Model}} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make([]*{{.Model}}, len(r.records))
+	for i, record := range r.records {
+		c := *record
+		ret[i] = &c
+	}
+	return ret
+}
+
+{{- if not .Table.View}}
+
+func (r *Fake{{.Model}}Repository) Insert(ctx context.Context, record *{{.Model}}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+{{- with $auto}}
+	id := record.{{.GoName}}
+	if id == 0 {
+		id = r.lastInsertId + 1
+	}
+{{- end}}
+{{- with $pk}}
+	// Check duplicates before any change, so that rejected records leave no state
+	if r.indexOf({{range $i, $c := .Columns}}{{if $i}}, {{end}}{{if and $auto (eq $c.FieldName $auto.FieldName)}}id{{else}}record.{{$c.GoName}}{{end}}{{end}}) >= 0 {
+		return fmt.Errorf("duplicate primary key of {{$.Model}}")
+	}
+{{- end}}
+{{- with $auto}}
+	record.{{.GoName}} = id
+	if id > r.lastInsertId {
+		r.lastInsertId = id
+	}
+{{- end}}
+	c := *record
+	r.records = append(r.records, &c)
+	return nil
+}
+{{- end}}
+{{- with $pk}}
+
+func (r *Fake{{$.Model}}Repository) Update(ctx context.Context, {{.Params}}, update *Update{{$.Model}}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i := r.indexOf({{.Args}})
+	if i < 0 {
+		return nil
+	}
+	record := r.records[i]
+{{- range $.Table.UpdateColumns}}
+	if update.{{.GoName}} != nil {
+		record.{{.GoName}} = *update.{{.GoName}}
+	}
+{{- end}}
+	return nil
+}
+
+func (r *Fake{{$.Model}}Repository) Delete(ctx context.Context, {{.Params}}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if i := r.indexOf({{.Args}}); i >= 0 {
+		r.records = append(r.records[:i], r.records[i+1:]...)
+	}
+	return nil
+}
+
+func (r *Fake{{$.Model}}Repository) indexOf({{.Params}}) int {
+	for i, record := range r.records {
+		if {{.Match "record"}} {
+			return i
+		}
+	}
+	return -1
+}
+{{- end}}
+{{- range .Table.IndexFinders}}
+{{- if .Unique}}
+
+func (r *Fake{{$.Model}}Repository) {{.Method}}(ctx context.Context, {{.Params}}) (*{{$.Model}}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, record := range r.records {
+		if {{.Match "record"}} {
+			c := *record
+			return &c, nil
+		}
+	}
+	return nil, iface.ErrRecordNotFound{}
+}
+{{- else}}
+
+func (r *Fake{{$.Model}}Repository) {{.Method}}(ctx context.Context, {{.Params}}) ([]*{{$.Model}}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := []*{{$.Model}}{}
+	for _, record := range r.records {
+		if {{.Match "record"}} {
+			c := *record
+			ret = append(ret, &c)
+		}
+	}
+	return ret, nil
+}
+{{- end}}
+{{- end}}
+`
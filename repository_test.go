@@ -0,0 +1,179 @@
+package exql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go/format"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/mocks/mock_iface"
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/model/mock_model"
+	"github.com/loilo-inc/exql/v3/null"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTable_RepositoryImports(t *testing.T) {
+	pk := sql.NullString{String: "PRI", Valid: true}
+	t.Run("with primary key", func(t *testing.T) {
+		table := &Table{
+			TableName: "histories",
+			Columns: []*Column{
+				{FieldName: "id", GoFieldType: "int64", Key: pk},
+				{FieldName: "created_at", GoFieldType: "time.Time", Key: pk},
+			},
+		}
+		assert.Equal(t, `import "context"
+import "fmt"
+import "reflect"
+import "sync"
+import "time"
+import "github.com/loilo-inc/exql/v3/iface"`, table.RepositoryImports())
+	})
+	t.Run("without primary key", func(t *testing.T) {
+		table := &Table{
+			TableName: "logs",
+			Columns:   []*Column{{FieldName: "body", GoFieldType: "string"}},
+		}
+		assert.Equal(t, `import "context"
+import "sync"
+import "github.com/loilo-inc/exql/v3/iface"`, table.RepositoryImports())
+	})
+}
+
+func TestGenerateOptions_Repositories(t *testing.T) {
+	table := &Table{
+		TableName: "logs",
+		Columns:   []*Column{{FieldName: "body", GoFieldType: "string"}},
+	}
+	templates, err := parseModelTemplates(&GenerateOptions{Repositories: true})
+	assert.NoError(t, err)
+	files, err := generateModelFiles(table, templates, &GenerateOptions{OutDir: "dist", Package: "model"})
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "dist/logs_repository.go", files[1].path)
+	source, err := format.Source(files[1].source)
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), "type LogsRepository interface {")
+	assert.Contains(t, string(source), "//go:generate go run go.uber.org/mock/mockgen@v0.6.0 -source=$GOFILE")
+	assert.NotContains(t, string(source), "Update(")
+	assert.NotContains(t, string(source), "Delete(")
+}
+
+func TestGeneratedRepository(t *testing.T) {
+	ctx := context.Background()
+	t.Run("exql", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		repo := model.NewUsersRepository(NewDB(db))
+		mock.ExpectExec("INSERT INTO `users` \\(`age`,`name`\\) VALUES \\(\\?,\\?\\)").
+			WithArgs(int64(10), "go").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE `users` SET `name` = \\? WHERE `users`.`id` = \\?").
+			WithArgs("exql", int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\?").
+			WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}).AddRow(1, "exql", 10))
+		mock.ExpectExec("DELETE FROM `users` WHERE `users`.`id` = \\?").
+			WithArgs(int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		user := &model.Users{Name: "go", Age: 10}
+		assert.NoError(t, repo.Insert(ctx, user))
+		assert.Equal(t, int64(1), user.Id)
+		name := "exql"
+		assert.NoError(t, repo.Update(ctx, 1, &model.UpdateUsers{Name: &name}))
+		found, err := repo.FindById(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, &model.Users{Id: 1, Name: "exql", Age: 10}, found)
+		assert.NoError(t, repo.Delete(ctx, 1))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("exql with mocks of iface", func(t *testing.T) {
+		db := mock_iface.NewMockSaverFinder(gomock.NewController(t))
+		db.EXPECT().FindContext(ctx, gomock.Any(), gomock.Any()).Return(ErrRecordNotFound{})
+		_, err := model.NewUsersRepository(db).FindById(ctx, 1)
+		assert.ErrorIs(t, err, ErrRecordNotFound{})
+	})
+	t.Run("fake", func(t *testing.T) {
+		repo := model.NewFakeUsersRepository(&model.Users{Id: 5, Name: "go", Age: 10})
+		user := &model.Users{Name: "exql", Age: 20}
+		assert.NoError(t, repo.Insert(ctx, user))
+		assert.Equal(t, int64(6), user.Id)
+		assert.EqualError(t, repo.Insert(ctx, &model.Users{Id: 5}), "duplicate primary key of Users")
+
+		age := int64(11)
+		assert.NoError(t, repo.Update(ctx, 5, &model.UpdateUsers{Age: &age}))
+		found, err := repo.FindById(ctx, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, &model.Users{Id: 5, Name: "go", Age: 11}, found)
+		found.Name = "modified"
+		assert.Equal(t, "go", repo.Records()[0].Name)
+
+		assert.NoError(t, repo.Delete(ctx, 5))
+		_, err = repo.FindById(ctx, 5)
+		assert.True(t, errors.Is(err, ErrRecordNotFound{}))
+		assert.Equal(t, []*model.Users{{Id: 6, Name: "exql", Age: 20}}, repo.Records())
+	})
+	t.Run("fake with indexes", func(t *testing.T) {
+		repo := model.NewFakeGroupUsersRepository(
+			&model.GroupUsers{Id: 1, UserId: 1, GroupId: 1},
+			&model.GroupUsers{Id: 2, UserId: 1, GroupId: 2},
+		)
+		list, err := repo.FindByUserId(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, list, 2)
+		list, err = repo.FindByGroupId(ctx, 3)
+		assert.NoError(t, err)
+		assert.NotNil(t, list)
+		assert.Empty(t, list)
+	})
+	t.Run("fake with nullable unique key", func(t *testing.T) {
+		repo := model.NewFakeFieldsRepository(
+			&model.Fields{Id: 1, VarcharNullField: null.New("a")},
+			&model.Fields{Id: 2},
+		)
+		found, err := repo.FindByVarcharNullField(ctx, null.New("a"))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), found.Id)
+		// NULL matches nothing as `varchar_null_field = NULL` does
+		_, err = repo.FindByVarcharNullField(ctx, null.String{})
+		assert.ErrorIs(t, err, ErrRecordNotFound{})
+	})
+	t.Run("fake with composite primary key", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		repo := model.NewFakeUserLoginHistoriesRepository(&model.UserLoginHistories{Id: 1, UserId: 1, CreatedAt: createdAt})
+		found, err := repo.FindByIdAndCreatedAt(ctx, 1, createdAt.In(time.Local))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), found.UserId)
+	})
+	t.Run("fake keeps the state on duplicate inserts", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		repo := model.NewFakeUserLoginHistoriesRepository(&model.UserLoginHistories{Id: 3, UserId: 1, CreatedAt: createdAt})
+		dup := &model.UserLoginHistories{Id: 3, UserId: 2, CreatedAt: createdAt}
+		assert.EqualError(t, repo.Insert(ctx, dup), "duplicate primary key of UserLoginHistories")
+		assert.Equal(t, &model.UserLoginHistories{Id: 3, UserId: 2, CreatedAt: createdAt}, dup)
+		assert.Len(t, repo.Records(), 1)
+
+		next := &model.UserLoginHistories{UserId: 2, CreatedAt: createdAt}
+		assert.NoError(t, repo.Insert(ctx, next))
+		assert.Equal(t, int64(4), next.Id)
+	})
+	t.Run("gomock", func(t *testing.T) {
+		var repo model.UsersRepository = mock_model.NewMockUsersRepository(gomock.NewController(t))
+		repo.(*mock_model.MockUsersRepository).EXPECT().FindById(ctx, int64(1)).Return(&model.Users{Id: 1}, nil)
+		found, err := repo.FindById(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), found.Id)
+	})
+	t.Run("fake panics with invalid records", func(t *testing.T) {
+		assert.Panics(t, func() {
+			model.NewFakeUsersRepository(&model.Users{Id: 1}, &model.Users{Id: 1})
+		})
+	})
+}
@@ -9,23 +9,6 @@ import (
 	q "github.com/loilo-inc/exql/v3/query"
 )
 
-type Saver interface {
-	Insert(structPtr Model) (sql.Result, error)
-	InsertContext(ctx context.Context, structPtr Model) (sql.Result, error)
-	Update(table string, set map[string]any, where q.Condition) (sql.Result, error)
-	UpdateModel(updaterStructPtr ModelUpdate, where q.Condition) (sql.Result, error)
-	UpdateContext(ctx context.Context, table string, set map[string]any, where q.Condition) (sql.Result, error)
-	UpdateModelContext(ctx context.Context, updaterStructPtr ModelUpdate, where q.Condition) (sql.Result, error)
-	Delete(table string, where q.Condition) (sql.Result, error)
-	DeleteContext(ctx context.Context, table string, where q.Condition) (sql.Result, error)
-	Exec(query q.Query) (sql.Result, error)
-	ExecContext(ctx context.Context, query q.Query) (sql.Result, error)
-	Query(query q.Query) (*sql.Rows, error)
-	QueryContext(ctx context.Context, query q.Query) (*sql.Rows, error)
-	QueryRow(query q.Query) (*sql.Row, error)
-	QueryRowContext(ctx context.Context, query q.Query) (*sql.Row, error)
-}
-
 type saver struct {
 	ex Executor
 }
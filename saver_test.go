@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/loilo-inc/exql/v3/meta"
 	"github.com/loilo-inc/exql/v3/mocks/mock_iface"
 	"github.com/loilo-inc/exql/v3/mocks/mock_query"
 	"github.com/loilo-inc/exql/v3/model"
@@ -437,3 +438,55 @@ func TestSaver_QueryExtra(t *testing.T) {
 		assert.Equal(t, aErr, err)
 	})
 }
+
+type readOnlyTestModel struct {
+	Id int64 `exql:"column:id;primary"`
+}
+
+func (readOnlyTestModel) TableName() string {
+	return "active_users"
+}
+
+func (readOnlyTestModel) TableMetadata() *meta.Table {
+	return &meta.Table{Name: "active_users", Columns: []string{"id"}, ReadOnly: true}
+}
+
+func TestSaver_Insert_ReadOnly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	s := NewSaver(db)
+	_, err = s.Insert(&readOnlyTestModel{Id: 1})
+	assert.EqualError(t, err, "model of active_users is read-only")
+	var readOnly ErrReadOnlyModel
+	assert.ErrorAs(t, err, &readOnly)
+	assert.Equal(t, "active_users", readOnly.TableName)
+	_, err = QueryForBulkInsert(&readOnlyTestModel{Id: 1})
+	assert.ErrorAs(t, err, &readOnly)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+type readOnlyTestModelUpdate struct {
+	Id *int64 `exql:"column:id"`
+}
+
+func (readOnlyTestModelUpdate) UpdateTableName() string {
+	return "active_users"
+}
+
+func (readOnlyTestModelUpdate) TableMetadata() *meta.Table {
+	return readOnlyTestModel{}.TableMetadata()
+}
+
+func TestSaver_Update_ReadOnly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	s := NewSaver(db)
+	id := int64(2)
+	var readOnly ErrReadOnlyModel
+	_, err = s.UpdateModel(&readOnlyTestModelUpdate{Id: &id}, Where("id = ?", 1))
+	assert.ErrorAs(t, err, &readOnly)
+	assert.Equal(t, "active_users", readOnly.TableName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
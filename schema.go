@@ -0,0 +1,243 @@
+package exql
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// SchemaSnapshot is the machine-readable snapshot of generated tables, written to GenerateOptions.SchemaSnapshot.
+type SchemaSnapshot struct {
+	Tables []*SnapshotTable `json:"tables"`
+}
+
+// SnapshotTable is the table and its model in SchemaSnapshot.
+type SnapshotTable struct {
+	Name string `json:"name"`
+	// Model is the name of the model struct.
+	Model       string            `json:"model"`
+	View        bool              `json:"view"`
+	Comment     string            `json:"comment,omitempty"`
+	Columns     []*SnapshotColumn `json:"columns"`
+	Indexes     []*Index          `json:"indexes,omitempty"`
+	ForeignKeys []*ForeignKey     `json:"foreign_keys,omitempty"`
+}
+
+// SnapshotColumn is the column and its model field in SchemaSnapshot.
+// GoType is the type of the field, after enum types and type mappings are applied.
+type SnapshotColumn struct {
+	Name string `json:"name"`
+	// Type is the MySQL column type, e.g. "varchar(255)".
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+	// Default is the default value of the column, or nil if it has none.
+	Default *string `json:"default"`
+	// Key is "PRI", "UNI" or "MUL" if the column is indexed.
+	Key          string `json:"key,omitempty"`
+	Extra        string `json:"extra,omitempty"`
+	Comment      string `json:"comment,omitempty"`
+	CharacterSet string `json:"character_set,omitempty"`
+	Collation    string `json:"collation,omitempty"`
+	// Field is the name of the struct field, and JSONName is the key of it encoded by encoding/json.
+	Field    string `json:"field"`
+	JSONName string `json:"json_name"`
+	GoType   string `json:"go_type"`
+}
+
+// newSchemaSnapshot returns the snapshot of tables.
+func newSchemaSnapshot(tables []*Table) *SchemaSnapshot {
+	ret := &SchemaSnapshot{Tables: []*SnapshotTable{}}
+	for _, t := range tables {
+		st := &SnapshotTable{
+			Name:        t.TableName,
+			Model:       strcase.ToCamel(t.TableName),
+			View:        t.View,
+			Comment:     t.Comment,
+			Columns:     []*SnapshotColumn{},
+			Indexes:     t.Indexes,
+			ForeignKeys: t.ForeignKeys,
+		}
+		for _, c := range t.Columns {
+			sc := &SnapshotColumn{
+				Name:         c.FieldName,
+				Type:         c.FieldType,
+				Nullable:     c.Nullable,
+				Key:          c.Key.String,
+				Extra:        c.Extra.String,
+				Comment:      c.Comment,
+				CharacterSet: c.CharacterSet,
+				Collation:    c.Collation,
+				Field:        c.GoName(),
+				JSONName:     strcase.ToSnake(c.FieldName),
+				GoType:       c.GoFieldType,
+			}
+			if c.DefaultValue.Valid {
+				v := c.DefaultValue.String
+				sc.Default = &v
+			}
+			st.Columns = append(st.Columns, sc)
+		}
+		ret.Tables = append(ret.Tables, st)
+	}
+	return ret
+}
+
+// jsonSchemaDialect is the JSON Schema version of documents generated by Table.JSONSchema.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// decimalPattern is the pattern of decimals encoded as JSON strings by decimal.Decimal.
+const decimalPattern = `^-?[0-9]+(\.[0-9]+)?$`
+
+// JSONSchema is the subset of JSON Schema describing models encoded by encoding/json.
+type JSONSchema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type is the type name, or the list of type names for nullable columns. Any value is allowed if nil.
+	Type                 any                    `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	ContentEncoding      string                 `json:"contentEncoding,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	Minimum              *int64                 `json:"minimum,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	ReadOnly             bool                   `json:"readOnly,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+}
+
+// JSONSchema returns the JSON Schema document of the model, keyed by json tags of fields.
+// All fields are required since they are always encoded, and nullable columns allow null.
+// Fields of types given by type mappings allow any value.
+func (t *Table) JSONSchema() *JSONSchema {
+	enums := map[string]*EnumType{}
+	for _, e := range t.EnumTypes() {
+		enums[e.Name] = e
+	}
+	additional := false
+	ret := &JSONSchema{
+		Schema:               jsonSchemaDialect,
+		Title:                strcase.ToCamel(t.TableName),
+		Description:          t.Comment,
+		Type:                 "object",
+		ReadOnly:             t.View,
+		Properties:           map[string]*JSONSchema{},
+		AdditionalProperties: &additional,
+	}
+	for _, c := range t.Columns {
+		name := strcase.ToSnake(c.FieldName)
+		ret.Properties[name] = c.jsonSchema(enums)
+		ret.Required = append(ret.Required, name)
+	}
+	return ret
+}
+
+// jsonSchema returns the JSON Schema of the field of the column.
+func (c *Column) jsonSchema(enums map[string]*EnumType) *JSONSchema {
+	typ := c.GoFieldType
+	inner, nullable := nullInnerTypes[typ]
+	if strings.HasPrefix(typ, "null.Null[") && strings.HasSuffix(typ, "]") {
+		inner, nullable = strings.TrimSuffix(strings.TrimPrefix(typ, "null.Null["), "]"), true
+	} else if strings.HasPrefix(typ, "*") {
+		inner, nullable = strings.TrimPrefix(typ, "*"), true
+	} else if !nullable {
+		inner = typ
+	}
+	ret := &JSONSchema{Description: c.Comment}
+	var name string
+	switch inner {
+	case boolType:
+		name = "boolean"
+	case int64Type:
+		name = "integer"
+	case uint64Type:
+		name = "integer"
+		var zero int64
+		ret.Minimum = &zero
+	case float32Type, float64Type:
+		name = "number"
+	case decimalType:
+		name, ret.Pattern = "string", decimalPattern
+	case timeType:
+		name, ret.Format = "string", "date-time"
+	case strType:
+		name = "string"
+		if charPat.MatchString(c.FieldType) {
+			size := c.factorySize()
+			ret.MaxLength = &size
+		}
+		if values := c.EnumValues(); values != nil && !c.IsSet() {
+			for _, v := range values {
+				ret.Enum = append(ret.Enum, v)
+			}
+		}
+	case bytesType:
+		name, ret.ContentEncoding = "string", "base64"
+	}
+	if e, ok := enums[inner]; ok {
+		values := make([]any, 0, len(e.Values))
+		for _, v := range c.EnumValues() {
+			values = append(values, v)
+		}
+		if e.Set {
+			ret.Items = &JSONSchema{Type: "string", Enum: values}
+			name = "array"
+		} else {
+			ret.Enum = values
+			name = "string"
+		}
+	}
+	if name == "" {
+		return ret
+	}
+	ret.Type = name
+	if nullable {
+		ret.Type = []string{name, "null"}
+		if ret.Enum != nil {
+			ret.Enum = append(ret.Enum, nil)
+		}
+	}
+	return ret
+}
+
+// generateSchemaFiles generates the schema snapshot and JSON Schema documents of tables as configured by opts.
+func generateSchemaFiles(tables []*Table, opts *GenerateOptions) ([]*modelFileOutput, error) {
+	var outputs []*modelFileOutput
+	if opts.SchemaSnapshot != "" {
+		source, err := marshalSchemaJSON(newSchemaSnapshot(tables))
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, &modelFileOutput{path: opts.SchemaSnapshot, source: source})
+	}
+	if opts.JSONSchemaDir != "" {
+		for _, table := range tables {
+			source, err := marshalSchemaJSON(table.JSONSchema())
+			if err != nil {
+				return nil, err
+			}
+			outputs = append(outputs, &modelFileOutput{
+				path:   filepath.Join(opts.JSONSchemaDir, strcase.ToSnake(table.TableName)+".schema.json"),
+				source: source,
+			})
+		}
+	}
+	return outputs, nil
+}
+
+// marshalSchemaJSON encodes v as indented JSON ending with a newline, without escaping HTML characters in comments.
+func marshalSchemaJSON(v any) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -90,5 +90,23 @@ create table fields (
     longblob_null_field longblob,
     json_field json not null,
     json_null_field json,
+    bool_field tinyint(1) not null,
+    bool_null_field tinyint(1),
+    decimal_field decimal(10,2) not null,
+    decimal_null_field decimal(10,2),
+    enum_field enum('a','b') not null,
+    enum_null_field enum('a','b'),
+    set_field set('a','b') not null,
+    set_null_field set('a','b'),
+    bit_field bit(8) not null,
+    bit_null_field bit(8),
+    year_field year not null,
+    year_null_field year,
+    binary_field binary(4) not null,
+    binary_null_field binary(4),
+    varbinary_field varbinary(255) not null,
+    varbinary_null_field varbinary(255),
+    geometry_null_field geometry,
     primary key (id)
 );
+create unique index fields_varchar_null_field on fields(varchar_null_field);
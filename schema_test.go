@@ -0,0 +1,194 @@
+package exql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_JSONSchema(t *testing.T) {
+	table := &Table{
+		TableName: "users",
+		Comment:   "Registered users",
+		Columns: []*Column{
+			{FieldName: "id", FieldType: "bigint(20) unsigned", GoFieldType: "uint64", Key: sql.NullString{String: "PRI", Valid: true}},
+			{FieldName: "name", FieldType: "varchar(16)", GoFieldType: "string", Comment: "Display name"},
+			{FieldName: "age", FieldType: "int(11)", GoFieldType: "null.Int64", Nullable: true},
+			{FieldName: "status", FieldType: "enum('active','banned')", GoFieldType: "string"},
+			{FieldName: "roles", FieldType: "set('admin','member')", GoFieldType: "null.String", Nullable: true},
+			{FieldName: "balance", FieldType: "decimal(10,2)", GoFieldType: "decimal.Decimal"},
+			{FieldName: "avatar", FieldType: "blob", GoFieldType: "[]byte"},
+			{FieldName: "profile", FieldType: "json", GoFieldType: "json.RawMessage"},
+			{FieldName: "created_at", FieldType: "datetime", GoFieldType: "time.Time"},
+			{FieldName: "owner_id", FieldType: "bigint(20)", GoFieldType: "*types.UserID", Nullable: true},
+		},
+	}
+	applyEnumTypes(table)
+	actual, err := json.Marshal(table.JSONSchema())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "Users",
+		"description": "Registered users",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "minimum": 0},
+			"name": {"type": "string", "maxLength": 16, "description": "Display name"},
+			"age": {"type": ["integer", "null"]},
+			"status": {"type": "string", "enum": ["active", "banned"]},
+			"roles": {"type": ["array", "null"], "items": {"type": "string", "enum": ["admin", "member"]}},
+			"balance": {"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]+)?$"},
+			"avatar": {"type": "string", "contentEncoding": "base64"},
+			"profile": {},
+			"created_at": {"type": "string", "format": "date-time"},
+			"owner_id": {}
+		},
+		"required": ["id", "name", "age", "status", "roles", "balance", "avatar", "profile", "created_at", "owner_id"],
+		"additionalProperties": false
+	}`, string(actual))
+
+	t.Run("nullable enum", func(t *testing.T) {
+		table := &Table{TableName: "logs", Columns: []*Column{
+			{FieldName: "status", FieldType: "enum('a')", GoFieldType: "null.String", Nullable: true},
+		}}
+		applyEnumTypes(table)
+		assert.Equal(t, []any{"a", nil}, table.JSONSchema().Properties["status"].Enum)
+	})
+	t.Run("views are read-only", func(t *testing.T) {
+		assert.True(t, (&Table{TableName: "active_users", View: true}).JSONSchema().ReadOnly)
+	})
+}
+
+func TestSchemaSnapshot_JSON(t *testing.T) {
+	table := &Table{
+		TableName: "users",
+		Comment:   "Registered users",
+		Columns: []*Column{
+			{
+				FieldName:   "id",
+				FieldType:   "int(11)",
+				GoFieldType: "int64",
+				Key:         sql.NullString{String: "PRI", Valid: true},
+				Extra:       sql.NullString{String: "auto_increment", Valid: true},
+			},
+			{
+				FieldName:    "status",
+				FieldType:    "enum('active','banned')",
+				GoFieldType:  "string",
+				DefaultValue: sql.NullString{String: "active", Valid: true},
+				Comment:      "<active> or <banned>",
+			},
+			{FieldName: "age", FieldType: "int(11)", GoFieldType: "null.Int64", Nullable: true},
+		},
+		Indexes: []*Index{{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true}},
+	}
+	applyEnumTypes(table)
+	actual, err := marshalSchemaJSON(newSchemaSnapshot([]*Table{table}))
+	assert.NoError(t, err)
+	assert.Equal(t, `{
+  "tables": [
+    {
+      "name": "users",
+      "model": "Users",
+      "view": false,
+      "comment": "Registered users",
+      "columns": [
+        {
+          "name": "id",
+          "type": "int(11)",
+          "nullable": false,
+          "default": null,
+          "key": "PRI",
+          "extra": "auto_increment",
+          "field": "Id",
+          "json_name": "id",
+          "go_type": "int64"
+        },
+        {
+          "name": "status",
+          "type": "enum('active','banned')",
+          "nullable": false,
+          "default": "active",
+          "comment": "<active> or <banned>",
+          "field": "Status",
+          "json_name": "status",
+          "go_type": "UsersStatusEnum"
+        },
+        {
+          "name": "age",
+          "type": "int(11)",
+          "nullable": true,
+          "default": null,
+          "field": "Age",
+          "json_name": "age",
+          "go_type": "null.Int64"
+        }
+      ],
+      "indexes": [
+        {
+          "name": "PRIMARY",
+          "columns": [
+            "id"
+          ],
+          "unique": true,
+          "primary": true
+        }
+      ]
+    }
+  ]
+}
+`, string(actual))
+}
+
+func TestGenerateOptions_SchemaSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	opts := func() *GenerateOptions {
+		return &GenerateOptions{
+			OutDir:         filepath.Join(dir, "model"),
+			SchemaSnapshot: filepath.Join(dir, "schema.json"),
+			JSONSchemaDir:  filepath.Join(dir, "schemas"),
+		}
+	}
+	err := NewDDLGenerator("schema/model.sql").Generate(opts())
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "schema.json"))
+	assert.NoError(t, err)
+	var snapshot SchemaSnapshot
+	assert.NoError(t, json.Unmarshal(content, &snapshot))
+	assert.Len(t, snapshot.Tables, 5)
+	assert.Equal(t, "users", snapshot.Tables[0].Name)
+	assert.Equal(t, "name", snapshot.Tables[0].Columns[1].Name)
+	assert.Equal(t, "string", snapshot.Tables[0].Columns[1].GoType)
+	goTypes := map[string]string{}
+	for _, c := range snapshot.Tables[4].Columns {
+		goTypes[c.Name] = c.GoType
+	}
+	assert.Equal(t, "FieldsEnumFieldEnum", goTypes["enum_field"])
+
+	content, err = os.ReadFile(filepath.Join(dir, "schemas", "user_login_histories.schema.json"))
+	assert.NoError(t, err)
+	var schema JSONSchema
+	assert.NoError(t, json.Unmarshal(content, &schema))
+	assert.Equal(t, "UserLoginHistories", schema.Title)
+	assert.Equal(t, []string{"id", "user_id", "created_at"}, schema.Required)
+	assert.Equal(t, "date-time", schema.Properties["created_at"].Format)
+
+	t.Run("check", func(t *testing.T) {
+		checkOpts := opts()
+		checkOpts.Check = true
+		assert.NoError(t, NewDDLGenerator("schema/model.sql").Generate(checkOpts))
+
+		usersSchema := filepath.Join(dir, "schemas", "users.schema.json")
+		assert.NoError(t, os.WriteFile(usersSchema, []byte("{}\n"), 0640))
+		err := NewDDLGenerator("schema/model.sql").Generate(checkOpts)
+		var drift ErrSchemaDrift
+		if assert.ErrorAs(t, err, &drift) {
+			assert.Equal(t, []string{usersSchema}, drift.Changed)
+		}
+	})
+}
@@ -3,16 +3,32 @@ package exql
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/iancoleman/strcase"
 )
 
 type Table struct {
-	TableName string    `json:"table_name"`
-	Columns   []*Column `json:"columns"`
+	TableName string `json:"table_name"`
+	// View is true if the table is a view. Models of views are read-only and have no Update structs.
+	View        bool          `json:"view,omitempty"`
+	Comment     string        `json:"comment,omitempty"`
+	Columns     []*Column     `json:"columns"`
+	Indexes     []*Index      `json:"indexes,omitempty"`
+	ForeignKeys []*ForeignKey `json:"foreign_keys,omitempty"`
+	// Relations are the relations to other generated models, set by the generator.
+	Relations []*ModelRelation `json:"-"`
+	// UpdateKeys keeps primary key and auto_increment columns in UpdateColumns, set by the generator.
+	UpdateKeys bool `json:"-"`
+}
+
+// DocComment returns the table comment as the Go doc comment of the model, or empty if not commented.
+func (t *Table) DocComment() string {
+	return docComment(t.Comment, "")
 }
 
 func (t *Table) Fields() []string {
@@ -22,9 +38,69 @@ func (t *Table) Fields() []string {
 	}
 	return ret
 }
+
+// UpdateColumns returns the columns of the Update struct of the model.
+// Primary key and auto_increment columns are excluded unless UpdateKeys is set.
+func (t *Table) UpdateColumns() []*Column {
+	if t.UpdateKeys {
+		return t.Columns
+	}
+	var ret []*Column
+	for _, c := range t.Columns {
+		if !c.IsPrimary() && !c.IsAutoIncrement() {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// PrimaryKeyColumns returns the columns of the primary key in the key order.
+// The order of columns in the table is used if the table has no index metadata.
+func (t *Table) PrimaryKeyColumns() []*Column {
+	for _, idx := range t.Indexes {
+		if !idx.Primary {
+			continue
+		}
+		var ret []*Column
+		for _, name := range idx.Columns {
+			if c := t.Column(name); c != nil {
+				ret = append(ret, c)
+			}
+		}
+		return ret
+	}
+	var ret []*Column
+	for _, c := range t.Columns {
+		if c.IsPrimary() {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// AutoIncrementColumn returns the auto_increment column, or nil if none.
+func (t *Table) AutoIncrementColumn() *Column {
+	for _, c := range t.Columns {
+		if c.IsAutoIncrement() {
+			return c
+		}
+	}
+	return nil
+}
+
+// Column returns the column by the name, or nil if not found.
+func (t *Table) Column(name string) *Column {
+	for _, c := range t.Columns {
+		if c.FieldName == name {
+			return c
+		}
+	}
+	return nil
+}
+
 func (t *Table) HasNullField() bool {
 	for _, c := range t.Columns {
-		if c.Nullable {
+		if strings.HasPrefix(c.GoFieldType, "null.") {
 			return true
 		}
 	}
@@ -49,6 +125,95 @@ func (t *Table) HasJsonField() bool {
 	return false
 }
 
+func (t *Table) HasDecimalField() bool {
+	for _, c := range t.Columns {
+		if c.GoFieldType == "decimal.Decimal" {
+			return true
+		}
+	}
+	return false
+}
+
+// EnumType is the Go type generated for an ENUM or SET column.
+type EnumType struct {
+	Column *Column
+	// Name is the type name of the field, e.g. UsersStatusEnum.
+	Name string
+	// ValueName is the type name of values. It is same as Name for ENUM,
+	// and the element type of Name for SET, e.g. UsersRolesSetValue.
+	ValueName string
+	Set       bool
+	Values    []*EnumValue
+}
+
+// EnumValue is the constant of a value of EnumType.
+type EnumValue struct {
+	// Name is the name of the constant, e.g. UsersStatusEnumActive.
+	Name string
+	// Literal is the Go literal of the value.
+	Literal string
+}
+
+// enumTypeName returns the name of the generated Go type for ENUM or SET column.
+// It is suffixed by Enum or Set not to collide with other identifiers of the model,
+// such as UsersKey and UsersColumns for columns named key and columns.
+func (t *Table) enumTypeName(c *Column) string {
+	if c.IsSet() {
+		return strcase.ToCamel(t.TableName) + c.GoName() + "Set"
+	}
+	return strcase.ToCamel(t.TableName) + c.GoName() + "Enum"
+}
+
+// EnumTypes returns the Go types to be generated for ENUM and SET columns
+// whose fields are typed by them. See applyEnumTypes.
+func (t *Table) EnumTypes() []*EnumType {
+	var ret []*EnumType
+	for _, c := range t.Columns {
+		name := t.enumTypeName(c)
+		if c.EnumValues() == nil || (c.GoFieldType != name && c.GoFieldType != nullGenericType(name)) {
+			continue
+		}
+		e := &EnumType{Column: c, Name: name, ValueName: name, Set: c.IsSet()}
+		if e.Set {
+			e.ValueName = name + "Value"
+		}
+		used := map[string]bool{}
+		for _, v := range c.EnumValues() {
+			suffix := strcase.ToCamel(v)
+			if suffix == "" {
+				suffix = "Empty"
+			}
+			constName := name + suffix
+			for i := 2; used[constName]; i++ {
+				constName = fmt.Sprintf("%s%s%d", name, suffix, i)
+			}
+			used[constName] = true
+			e.Values = append(e.Values, &EnumValue{Name: constName, Literal: strconv.Quote(v)})
+		}
+		ret = append(ret, e)
+	}
+	return ret
+}
+
+func nullGenericType(t string) string {
+	return fmt.Sprintf("null.Null[%s]", t)
+}
+
+// applyEnumTypes types ENUM and SET columns by Go types generated for them instead of string.
+func applyEnumTypes(t *Table) {
+	for _, c := range t.Columns {
+		if c.EnumValues() == nil {
+			continue
+		}
+		switch c.GoFieldType {
+		case strType:
+			c.GoFieldType = t.enumTypeName(c)
+		case nullStrType:
+			c.GoFieldType = nullGenericType(t.enumTypeName(c))
+		}
+	}
+}
+
 type generatedModelFile struct {
 	// Name is the default generated file name.
 	Name string
@@ -56,20 +221,90 @@ type generatedModelFile struct {
 	Source []byte
 }
 
-type modelTemplateData struct {
-	Imports            string
-	Model              string
-	ModelLower         string
-	M                  string
-	Package            string
-	Fields             string
+// ModelTemplateData is the data passed to model templates.
+type ModelTemplateData struct {
+	// Table is the full metadata of the table.
+	Table *Table
+	// Imports are the import declarations required by the fields.
+	Imports string
+	// Model is the struct name of the model, e.g. UserGroups.
+	Model string
+	// ModelLower is Model in lower camel case, e.g. userGroups.
+	ModelLower string
+	// M is the receiver name of methods.
+	M       string
+	Package string
+	// Fields are the struct fields of the model.
+	Fields string
+	// UpdaterFields are the struct fields of the update model.
 	UpdaterFields      string
 	ScannedFields      string
 	TableNameGoLiteral string
 }
 
+// templateFuncs are the functions available in model templates.
+var templateFuncs = template.FuncMap{
+	"camel":      strcase.ToCamel,
+	"lowerCamel": strcase.ToLowerCamel,
+	"snake":      strcase.ToSnake,
+	"quote":      strconv.Quote,
+}
+
+// ParseModelTemplate parses the text of the model template with the functions for templates:
+// camel, lowerCamel, snake and quote.
+func ParseModelTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs).Parse(text)
+}
+
+// docComment turns the comment of the database into lines of the Go comment with indent.
+// Line breaks of any kind start new lines, and "*/" is broken so that
+// the comment can't terminate block comments that enclose the source.
+func docComment(comment, indent string) string {
+	comment = strings.NewReplacer("\r\n", "\n", "\r", "\n", "*/", "* /").Replace(comment)
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return ""
+	}
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if line == "" {
+			lines[i] = indent + "//"
+		} else {
+			lines[i] = indent + "// " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+var builtinModelTemplate = template.Must(ParseModelTemplate("model", modelTemplate))
+
 // GenerateModelFile builds a model file name and source without touching the filesystem.
 func (t *Table) GenerateModelFile(packageName string) (*generatedModelFile, error) {
+	source, err := t.GenerateFile(builtinModelTemplate, packageName)
+	if err != nil {
+		return nil, err
+	}
+	return &generatedModelFile{
+		Name:   fmt.Sprintf("%s.go", strcase.ToSnake(t.TableName)),
+		Source: source,
+	}, nil
+}
+
+// GenerateFile executes tmpl with ModelTemplateData of the table and returns the unformatted source.
+func (t *Table) GenerateFile(tmpl *template.Template, packageName string) ([]byte, error) {
+	data, err := t.templateData(packageName)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (t *Table) templateData(packageName string) (*ModelTemplateData, error) {
 	if t.TableName == "" {
 		return nil, errTableNameEmpty
 	}
@@ -78,33 +313,83 @@ func (t *Table) GenerateModelFile(packageName string) (*generatedModelFile, erro
 	}
 
 	var imports []string
+	enumTypes := t.EnumTypes()
+	diffPkgs := map[string]bool{}
+	if !t.View {
+		for _, f := range t.DiffFields(t.TableName[0:1], "after") {
+			diffPkgs[f.pkg] = true
+		}
+	}
+	if diffPkgs["bytes"] {
+		imports = append(imports, `import "bytes"`)
+	}
+	usesFinder := len(t.Relations) > 0 || len(t.IndexFinders()) > 0
+	if usesFinder {
+		imports = append(imports, `import "context"`)
+	}
+	if len(enumTypes) > 0 {
+		imports = append(imports, `import "database/sql/driver"`)
+	}
 	if t.HasJsonField() {
 		imports = append(imports, `import "encoding/json"`)
 	}
+	if len(enumTypes) > 0 {
+		imports = append(imports, `import "fmt"`)
+	}
+	if diffPkgs["reflect"] {
+		imports = append(imports, `import "reflect"`)
+	}
+	if slices.ContainsFunc(enumTypes, func(e *EnumType) bool { return e.Set }) {
+		imports = append(imports, `import "strings"`)
+	}
 	if t.HasTimeField() {
 		imports = append(imports, `import "time"`)
 	}
+	if t.HasDecimalField() {
+		imports = append(imports, `import "github.com/loilo-inc/exql/v3/decimal"`)
+	}
+	if usesFinder {
+		imports = append(imports, `import "github.com/loilo-inc/exql/v3/iface"`)
+	}
+	imports = append(imports, `import "github.com/loilo-inc/exql/v3/meta"`)
 	if t.HasNullField() {
 		imports = append(imports, `import "github.com/loilo-inc/exql/v3/null"`)
 	}
+	imports = append(imports, `import "github.com/loilo-inc/exql/v3/query"`)
+	for _, c := range t.Columns {
+		for _, path := range c.Imports {
+			imp := fmt.Sprintf("import %q", path)
+			if !slices.Contains(imports, imp) {
+				imports = append(imports, imp)
+			}
+		}
+	}
 
 	fields := strings.Builder{}
 	updateFields := strings.Builder{}
 	scannedFields := strings.Builder{}
 	for i, col := range t.Columns {
+		if doc := col.DocComment(); doc != "" {
+			fields.WriteString(doc + "\n")
+		}
 		scannedFields.WriteString(fmt.Sprintf(
 			"\t&%s.%s,", t.TableName[0:1], col.Field()),
 		)
 		fields.WriteString(fmt.Sprintf("\t%s", col.Field()))
-		updateFields.WriteString(fmt.Sprintf("\t%s", col.UpdateField()))
 		if i < len(t.Columns)-1 {
 			scannedFields.WriteString("\n")
 			fields.WriteString("\n")
+		}
+	}
+	for i, col := range t.UpdateColumns() {
+		if i > 0 {
 			updateFields.WriteString("\n")
 		}
+		updateFields.WriteString(fmt.Sprintf("\t%s", col.UpdateField()))
 	}
 
-	data := &modelTemplateData{
+	return &ModelTemplateData{
+		Table:              t,
 		Imports:            strings.Join(imports, "\n"),
 		Model:              strcase.ToCamel(t.TableName),
 		ModelLower:         strcase.ToLowerCamel(t.TableName),
@@ -114,31 +399,23 @@ func (t *Table) GenerateModelFile(packageName string) (*generatedModelFile, erro
 		Fields:             fields.String(),
 		TableNameGoLiteral: strconv.Quote(t.TableName),
 		ScannedFields:      scannedFields.String(),
-	}
-	tmpl := template.Must(template.New("model").Parse(modelTemplate))
-	buf := &bytes.Buffer{}
-	if err := tmpl.Execute(buf, data); err != nil {
-		return nil, err
-	}
-
-	return &generatedModelFile{
-		Name:   fmt.Sprintf("%s.go", strcase.ToSnake(t.TableName)),
-		Source: buf.Bytes(),
 	}, nil
 }
 
-const modelTemplate = `// Code generated by exql. DO NOT EDIT.
+const modelTemplate = generatedFileHeader + `
 package {{.Package}}
 
 {{.Imports}}
 
-type {{.Model}} struct {
+{{with .Table.DocComment}}{{.}}
+{{end}}type {{.Model}} struct {
 {{.Fields}}
 }
 
 func ({{.M}} *{{.Model}}) TableName() string {
 	return {{.Model}}TableName
 }
+{{- if not .Table.View}}
 
 type Update{{.Model}} struct {
 {{.UpdaterFields}}
@@ -148,5 +425,334 @@ func ({{.M}} *Update{{.Model}}) UpdateTableName() string {
 	return {{.Model}}TableName
 }
 
+// NewUpdate{{.Model}} returns the empty Update{{.Model}} to set columns to be updated by setters.
+func NewUpdate{{.Model}}() *Update{{.Model}} {
+	return &Update{{.Model}}{}
+}
+{{- range .Table.UpdateColumns}}
+
+// Set{{.GoName}} sets {{.FieldName}} to be updated.
+func ({{$.M}} *Update{{$.Model}}) Set{{.GoName}}(v {{.GoFieldType}}) *Update{{$.Model}} {
+	{{$.M}}.{{.GoName}} = &v
+	return {{$.M}}
+}
+{{- if .HasNullType}}
+
+// SetNull{{.GoName}} sets {{.FieldName}} to be updated to NULL.
+func ({{$.M}} *Update{{$.Model}}) SetNull{{.GoName}}() *Update{{$.Model}} {
+	var v {{.GoFieldType}}
+	{{$.M}}.{{.GoName}} = &v
+	return {{$.M}}
+}
+{{- end}}
+{{- end}}
+
+// DiffUpdate returns Update{{.Model}} with the columns changed from {{.M}} to after, or nil if nothing is changed.
+// Columns not in Update{{.Model}} are not compared.
+func ({{.M}} *{{.Model}}) DiffUpdate(after *{{.Model}}) *Update{{.Model}} {
+	update := &Update{{.Model}}{}
+	changed := false
+{{- range .Table.DiffFields .M "after"}}
+	if !({{.Equal}}) {
+		update.Set{{.Column.GoName}}(after.{{.Column.GoName}})
+		changed = true
+	}
+{{- end}}
+	if !changed {
+		return nil
+	}
+	return update
+}
+{{- end}}
+
+func ({{.M}} *{{.Model}}) TableMetadata() *meta.Table {
+	return {{.Model}}Metadata
+}
+
 const {{.Model}}TableName = {{.TableNameGoLiteral}}
+
+// Column names of {{.Model}}.
+const (
+{{- range .Table.Columns}}
+	{{$.Model}}Column{{.GoName}} = {{quote .FieldName}}
+{{- end}}
+)
+
+// {{.Model}}ColumnNames are the column names of {{.Model}} in the table order.
+var {{.Model}}ColumnNames = []string{
+{{- range .Table.Columns}}
+	{{$.Model}}Column{{.GoName}},
+{{- end}}
+}
+
+// {{.Model}}PrimaryKeyColumnNames are the column names of the primary key of {{.Model}} in the key order.
+var {{.Model}}PrimaryKeyColumnNames = []string{
+{{- range .Table.PrimaryKeyColumns}}
+	{{$.Model}}Column{{.GoName}},
+{{- end}}
+}
+
+// {{.Model}}Columns are the typed handles of the columns of {{.Model}} for building conditions.
+var {{.Model}}Columns = struct {
+{{- range .Table.Columns}}
+	{{.GoName}} query.Column[{{.GoFieldType}}]
+{{- end}}
+}{
+{{- range .Table.Columns}}
+	{{.GoName}}: query.NewColumn[{{.GoFieldType}}]({{$.Model}}TableName, {{$.Model}}Column{{.GoName}}),
+{{- end}}
+}
+
+// {{.Model}}Metadata is the static metadata of {{.Model}}.
+var {{.Model}}Metadata = &meta.Table{
+	Name:       {{.Model}}TableName,
+	Columns:    {{.Model}}ColumnNames,
+	PrimaryKey: {{.Model}}PrimaryKeyColumnNames,
+{{- with .Table.AutoIncrementColumn}}
+	AutoIncrement: {{$.Model}}Column{{.GoName}},
+{{- end}}
+{{- if .Table.View}}
+	ReadOnly: true,
+{{- end}}
+}
+{{- with .Table.Relations}}
+
+// {{$.Model}}Relations are the relations of {{$.Model}} by foreign keys, to be passed to exql.Preload.
+var {{$.Model}}Relations = struct {
+{{- range .}}
+	{{.Name}} *meta.Relation
+{{- end}}
+}{
+{{- range .}}
+	{{.Name}}: &meta.Relation{
+		Kind:       meta.{{.KindName}},
+		Table:      {{.TargetModel}}TableName,
+		LocalKey:   {{$.Model}}Column{{.Column.GoName}},
+		ForeignKey: {{.TargetModel}}Column{{.TargetColumn.GoName}},
+	},
+{{- end}}
+}
+{{- end}}
+{{- range .Table.Relations}}
+{{- if .Many}}
+
+// Load{{.Name}} loads {{.TargetModel}} whose {{.TargetColumn.GoName}} refers {{.Column.GoName}}.
+func ({{$.M}} *{{$.Model}}) Load{{.Name}}(ctx context.Context, finder iface.Finder) ([]*{{.TargetModel}}, error) {
+	var dest []*{{.TargetModel}}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :? = ?",
+		query.Cols({{.TargetModel}}TableName), query.Cols({{.TargetModel}}Column{{.TargetColumn.GoName}}), {{$.M}}.{{.Column.GoName}},
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+{{- else}}
+
+// Load{{.Name}} loads {{.TargetModel}} whose {{.TargetColumn.GoName}} matches {{.Column.GoName}}.
+{{- if .Nullable}}
+// It returns nil if {{.Column.GoName}} is NULL.
+{{- end}}
+func ({{$.M}} *{{$.Model}}) Load{{.Name}}(ctx context.Context, finder iface.Finder) (*{{.TargetModel}}, error) {
+{{- if .Nullable}}
+	if !{{$.M}}.{{.Column.GoName}}.Valid {
+		return nil, nil
+	}
+{{- end}}
+	var dest {{.TargetModel}}
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE :? = ?",
+		query.Cols({{.TargetModel}}TableName), query.Cols({{.TargetModel}}Column{{.TargetColumn.GoName}}), {{$.M}}.{{.Column.GoName}},
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
+{{- end}}
+{{- end}}
+{{- with .Table.PrimaryKeyColumns}}
+
+// {{$.Model}}Key is the primary key of {{$.Model}} in the key order.
+type {{$.Model}}Key struct {
+{{- range .}}
+	{{.GoName}} {{.GoFieldType}}
+{{- end}}
+}
+
+// {{$.Model}}PrimaryKey is the handle of the primary key of {{$.Model}} for building conditions.
+var {{$.Model}}PrimaryKey = query.NewKey({{$.Model}}TableName{{range .}}, {{$.Model}}Column{{.GoName}}{{end}})
+
+// PrimaryKey returns the primary key of the model.
+func ({{$.M}} *{{$.Model}}) PrimaryKey() {{$.Model}}Key {
+	return {{$.Model}}Key{
+{{- range .}}
+		{{.GoName}}: {{$.M}}.{{.GoName}},
+{{- end}}
+	}
+}
+
+// Cond returns the condition matching the record of the key.
+func (k {{$.Model}}Key) Cond() query.Condition {
+	return {{$.Model}}PrimaryKey.Eq({{range $i, $c := .}}{{if $i}}, {{end}}k.{{$c.GoName}}{{end}})
+}
+
+// {{$.Model}}KeysIn returns the condition matching the records of the keys.
+// It results in an error if keys is empty.
+func {{$.Model}}KeysIn(keys ...{{$.Model}}Key) query.Condition {
+	tuples := make([][]any, len(keys))
+	for i, k := range keys {
+		tuples[i] = []any{ {{- range $i, $c := .}}{{if $i}}, {{end}}k.{{$c.GoName}}{{end -}} }
+	}
+	return {{$.Model}}PrimaryKey.In(tuples...)
+}
+
+// Find{{$.Model}}ByKeys finds {{$.Model}} by the primary keys. It returns an empty slice if not found.
+func Find{{$.Model}}ByKeys(ctx context.Context, finder iface.Finder, keys ...{{$.Model}}Key) ([]*{{$.Model}}, error) {
+	dest := []*{{$.Model}}{}
+	if len(keys) == 0 {
+		return dest, nil
+	}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE :?",
+		query.Cols({{$.Model}}TableName), {{$.Model}}KeysIn(keys...),
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+{{- end}}
+{{- range .Table.IndexFinders}}
+{{- if .Unique}}
+
+// {{.Name}} finds {{$.Model}} by the {{if .Primary}}primary{{else}}unique{{end}} key.
+// It returns exql.ErrRecordNotFound if not found.
+func {{.Name}}(ctx context.Context, finder iface.Finder, {{.Params}}) (*{{$.Model}}, error) {
+	var dest {{$.Model}}
+	if err := finder.FindContext(ctx, query.New(
+		"SELECT * FROM :? WHERE {{.Where}}",
+		query.Cols({{$.Model}}TableName), {{.Conds $.Model}},
+	), &dest); err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
+{{- else}}
+
+// {{.Name}} finds {{$.Model}} by the index. It returns an empty slice if not found.
+func {{.Name}}(ctx context.Context, finder iface.Finder, {{.Params}}) ([]*{{$.Model}}, error) {
+	var dest []*{{$.Model}}
+	if err := iface.FindManyOrEmptyContext(ctx, finder, query.New(
+		"SELECT * FROM :? WHERE {{.Where}}",
+		query.Cols({{$.Model}}TableName), {{.Conds $.Model}},
+	), &dest); err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+{{- end}}
+{{- end}}
+{{- range $e := .Table.EnumTypes}}
+{{- if .Set}}
+
+// {{.Name}} is the type of {{$.Model}}.{{.Column.GoName}}, a set of {{.ValueName}}.
+type {{.Name}} []{{.ValueName}}
+
+// {{.ValueName}} is a value of {{.Name}}.
+type {{.ValueName}} string
+{{- else}}
+
+// {{.Name}} is the type of {{$.Model}}.{{.Column.GoName}}.
+type {{.Name}} string
+{{- end}}
+
+const (
+{{- range .Values}}
+	{{.Name}} {{$e.ValueName}} = {{.Literal}}
+{{- end}}
+)
+
+// Valid reports whether v is one of the defined values.
+func (v {{.ValueName}}) Valid() bool {
+	switch v {
+	case {{range $i, $v := .Values}}{{if $i}}, {{end}}{{$v.Name}}{{end}}:
+		return true
+	}
+	return false
+}
+{{- if .Set}}
+
+// Valid reports whether all values of v are defined.
+func (v {{.Name}}) Valid() bool {
+	for _, e := range v {
+		if !e.Valid() {
+			return false
+		}
+	}
+	return true
+}
+
+// Scan implements sql.Scanner. It rejects unknown values.
+func (v *{{.Name}}) Scan(src any) error {
+	s, err := scan{{.Name}}(src)
+	if err != nil {
+		return err
+	}
+	set := {{.Name}}{}
+	if s != "" {
+		for _, e := range strings.Split(s, ",") {
+			set = append(set, {{.ValueName}}(e))
+		}
+	}
+	if !set.Valid() {
+		return fmt.Errorf("invalid value for {{.Name}}: %q", s)
+	}
+	*v = set
+	return nil
+}
+
+// Value implements driver.Valuer. It rejects unknown values.
+func (v {{.Name}}) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, fmt.Errorf("invalid value for {{.Name}}: %q", v)
+	}
+	list := make([]string, len(v))
+	for i, e := range v {
+		list[i] = string(e)
+	}
+	return strings.Join(list, ","), nil
+}
+{{- else}}
+
+// Scan implements sql.Scanner. It rejects unknown values.
+func (v *{{.Name}}) Scan(src any) error {
+	s, err := scan{{.Name}}(src)
+	if err != nil {
+		return err
+	}
+	if !{{.Name}}(s).Valid() {
+		return fmt.Errorf("invalid value for {{.Name}}: %q", s)
+	}
+	*v = {{.Name}}(s)
+	return nil
+}
+
+// Value implements driver.Valuer. It rejects unknown values.
+func (v {{.Name}}) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, fmt.Errorf("invalid value for {{.Name}}: %q", string(v))
+	}
+	return string(v), nil
+}
+{{- end}}
+
+func scan{{.Name}}(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	}
+	return "", fmt.Errorf("unsupported type for {{.Name}}: %T", src)
+}
+{{- end}}
 `
@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/loilo-inc/exql/v3/model"
+	"github.com/loilo-inc/exql/v3/null"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,6 +57,144 @@ func TestTable_GenerateModelFile(t *testing.T) {
 	assert.Regexp(t, regexp.MustCompile(`CreatedAt\s+time\.Time`), source)
 	assert.Regexp(t, regexp.MustCompile(`DeletedAt\s+null\.Time`), source)
 	assert.Contains(t, source, `const AuditLogsTableName = "audit_logs"`)
+	assert.Regexp(t, regexp.MustCompile(`AuditLogsColumnCreatedAt\s+= "created_at"`), source)
+	assert.Contains(t, source, "var AuditLogsPrimaryKeyColumnNames = []string{\n\tAuditLogsColumnId,\n}")
+	assert.Regexp(t, regexp.MustCompile(`AutoIncrement: AuditLogsColumnId,`), source)
+	assert.Contains(t, source, `import "github.com/loilo-inc/exql/v3/query"`)
+	assert.Regexp(t, regexp.MustCompile(`DeletedAt\s+query\.Column\[null\.Time\]`), source)
+	assert.Contains(t, source, "DeletedAt: query.NewColumn[null.Time](AuditLogsTableName, AuditLogsColumnDeletedAt),")
+}
+
+func TestTable_PrimaryKeyColumns(t *testing.T) {
+	table := &Table{
+		TableName: "histories",
+		Columns: []*Column{
+			{FieldName: "created_at", Key: sql.NullString{String: "PRI", Valid: true}},
+			{FieldName: "id", Key: sql.NullString{String: "PRI", Valid: true}},
+			{FieldName: "name"},
+		},
+	}
+	var names []string
+	for _, c := range table.PrimaryKeyColumns() {
+		names = append(names, c.FieldName)
+	}
+	assert.Equal(t, []string{"created_at", "id"}, names)
+
+	table.Indexes = []*Index{
+		{Name: "name", Columns: []string{"name"}},
+		{Name: "PRIMARY", Columns: []string{"id", "created_at"}, Unique: true, Primary: true},
+	}
+	names = nil
+	for _, c := range table.PrimaryKeyColumns() {
+		names = append(names, c.FieldName)
+	}
+	assert.Equal(t, []string{"id", "created_at"}, names)
+	assert.Nil(t, table.AutoIncrementColumn())
+	assert.Nil(t, table.Column("unknown"))
+}
+
+func TestTable_EnumTypes(t *testing.T) {
+	table := &Table{
+		TableName: "users",
+		Columns: []*Column{
+			{FieldName: "status", FieldType: "enum('active','banned','')", GoFieldType: "string"},
+			{FieldName: "roles", FieldType: "set('a-b','a_b')", GoFieldType: "null.String", Nullable: true},
+			{FieldName: "mapped", FieldType: "enum('a')", GoFieldType: "string"},
+			{FieldName: "name", FieldType: "varchar(255)", GoFieldType: "string"},
+		},
+	}
+	applyEnumTypes(table)
+	applyTypeMappings(table, []TypeMapping{{Column: "users.mapped", GoType: "string"}})
+	assert.Equal(t, "UsersStatusEnum", table.Columns[0].GoFieldType)
+	assert.Equal(t, "null.Null[UsersRolesSet]", table.Columns[1].GoFieldType)
+	assert.Equal(t, "string", table.Columns[2].GoFieldType)
+	assert.Equal(t, "string", table.Columns[3].GoFieldType)
+	assert.Equal(t, []*EnumType{
+		{
+			Column: table.Columns[0], Name: "UsersStatusEnum", ValueName: "UsersStatusEnum",
+			Values: []*EnumValue{
+				{Name: "UsersStatusEnumActive", Literal: `"active"`},
+				{Name: "UsersStatusEnumBanned", Literal: `"banned"`},
+				{Name: "UsersStatusEnumEmpty", Literal: `""`},
+			},
+		},
+		{
+			Column: table.Columns[1], Name: "UsersRolesSet", ValueName: "UsersRolesSetValue", Set: true,
+			Values: []*EnumValue{
+				{Name: "UsersRolesSetAB", Literal: `"a-b"`},
+				{Name: "UsersRolesSetAB2", Literal: `"a_b"`},
+			},
+		},
+	}, table.EnumTypes())
+
+	file, err := table.GenerateModelFile("dist")
+	assert.NoError(t, err)
+	fmted, err := format.Source(file.Source)
+	assert.NoError(t, err)
+	source := string(fmted)
+	assert.Contains(t, source, `import "database/sql/driver"`)
+	assert.Contains(t, source, `import "strings"`)
+	assert.Contains(t, source, "type UsersStatusEnum string")
+	assert.Contains(t, source, "type UsersRolesSet []UsersRolesSetValue")
+	assert.Contains(t, source, "case UsersStatusEnumActive, UsersStatusEnumBanned, UsersStatusEnumEmpty:")
+}
+
+func TestTable_EnumTypes_Collision(t *testing.T) {
+	// Types of columns named key and columns must not collide with UsersKey and UsersColumns
+	table := &Table{
+		TableName: "users",
+		Columns: []*Column{
+			{FieldName: "id", FieldType: "int", GoFieldType: "int64", Key: sql.NullString{String: "PRI", Valid: true}},
+			{FieldName: "key", FieldType: "enum('a')", GoFieldType: "string"},
+			{FieldName: "columns", FieldType: "set('a')", GoFieldType: "string"},
+		},
+	}
+	applyEnumTypes(table)
+	file, err := table.GenerateModelFile("dist")
+	assert.NoError(t, err)
+	source := string(file.Source)
+	assert.Contains(t, source, "type UsersKey struct")
+	assert.Contains(t, source, "type UsersKeyEnum string")
+	assert.Contains(t, source, "UsersColumns = struct")
+	assert.Contains(t, source, "type UsersColumnsSet []UsersColumnsSetValue")
+}
+
+func TestGeneratedEnumTypes(t *testing.T) {
+	t.Run("enum", func(t *testing.T) {
+		var v model.FieldsEnumFieldEnum
+		assert.NoError(t, v.Scan([]byte("b")))
+		assert.Equal(t, model.FieldsEnumFieldEnumB, v)
+		assert.EqualError(t, v.Scan("c"), `invalid value for FieldsEnumFieldEnum: "c"`)
+		assert.EqualError(t, v.Scan(1), "unsupported type for FieldsEnumFieldEnum: int")
+		val, err := model.FieldsEnumFieldEnumA.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "a", val)
+		_, err = model.FieldsEnumFieldEnum("c").Value()
+		assert.EqualError(t, err, `invalid value for FieldsEnumFieldEnum: "c"`)
+	})
+	t.Run("set", func(t *testing.T) {
+		var v model.FieldsSetFieldSet
+		assert.NoError(t, v.Scan("a,b"))
+		assert.Equal(t, model.FieldsSetFieldSet{model.FieldsSetFieldSetA, model.FieldsSetFieldSetB}, v)
+		assert.NoError(t, v.Scan([]byte("")))
+		assert.Equal(t, model.FieldsSetFieldSet{}, v)
+		assert.EqualError(t, v.Scan("a,c"), `invalid value for FieldsSetFieldSet: "a,c"`)
+		assert.EqualError(t, v.Scan(nil), "unsupported type for FieldsSetFieldSet: <nil>")
+		val, err := model.FieldsSetFieldSet{model.FieldsSetFieldSetB, model.FieldsSetFieldSetA}.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "b,a", val)
+		_, err = model.FieldsSetFieldSet{"c"}.Value()
+		assert.Error(t, err)
+	})
+	t.Run("null", func(t *testing.T) {
+		var v model.Fields
+		assert.NoError(t, v.EnumNullField.Scan("a"))
+		assert.Equal(t, null.New(model.FieldsEnumNullFieldEnumA), v.EnumNullField)
+		assert.NoError(t, v.SetNullField.Scan(nil))
+		assert.False(t, v.SetNullField.Valid)
+		_, err := null.New(model.FieldsEnumNullFieldEnum("c")).Value()
+		assert.Error(t, err)
+	})
 }
 
 func TestTable_GenerateModelFile_EscapesTableNameGoLiteral(t *testing.T) {
@@ -84,3 +224,104 @@ func TestTable_GenerateModelFile_RequiresTableName(t *testing.T) {
 	_, err := (&Table{}).GenerateModelFile("dist")
 	assert.ErrorIs(t, err, errTableNameEmpty)
 }
+
+func TestTable_GenerateModelFile_DocComments(t *testing.T) {
+	table := &Table{
+		TableName: "users",
+		Comment:   "All users.\r\nDeleted users are kept.",
+		Columns: []*Column{
+			{
+				FieldName:   "id",
+				FieldType:   "int(11)",
+				GoFieldType: "int64",
+				Key:         sql.NullString{String: "PRI", Valid: true},
+				Comment:     "ID */ func init() { panic(1) } /*",
+			},
+			{
+				FieldName:   "name",
+				FieldType:   "varchar(255)",
+				GoFieldType: "string",
+				Comment:     "Name\n\nfunc init() { panic(1) }\n",
+			},
+			{
+				FieldName:   "age",
+				FieldType:   "int(11)",
+				GoFieldType: "int64",
+				Comment:     " \n ",
+			},
+		},
+	}
+
+	file, err := table.GenerateModelFile("dist")
+	assert.NoError(t, err)
+	fmted, err := format.Source(file.Source)
+	assert.NoError(t, err)
+	source := string(fmted)
+	assert.Contains(t, source, "// All users.\n// Deleted users are kept.\ntype Users struct {\n")
+	assert.Contains(t, source, "\t// ID * / func init() { panic(1) } /*\n\tId int64")
+	assert.Contains(t, source, "\t// Name\n\t//\n\t// func init() { panic(1) }\n\tName string")
+	assert.Contains(t, source, "\tName string `exql:\"column:name;type:varchar(255);not null\" json:\"name\"`\n\tAge  int64")
+	assert.NotContains(t, source, "\nfunc init()")
+	assert.Contains(t, source, "type UpdateUsers struct {\n\tName *string")
+}
+
+func TestTable_UpdateColumns(t *testing.T) {
+	table := &Table{
+		TableName: "histories",
+		Columns: []*Column{
+			{FieldName: "id", Extra: sql.NullString{String: "auto_increment", Valid: true}},
+			{FieldName: "created_at", Key: sql.NullString{String: "PRI", Valid: true}},
+			{FieldName: "body"},
+		},
+	}
+	names := func(cols []*Column) []string {
+		var ret []string
+		for _, c := range cols {
+			ret = append(ret, c.FieldName)
+		}
+		return ret
+	}
+	assert.Equal(t, []string{"body"}, names(table.UpdateColumns()))
+	table.UpdateKeys = true
+	assert.Equal(t, []string{"id", "created_at", "body"}, names(table.UpdateColumns()))
+}
+
+func TestTable_GenerateModelFile_UpdateSetters(t *testing.T) {
+	table := &Table{
+		TableName: "users",
+		Columns: []*Column{
+			{FieldName: "id", FieldType: "int", GoFieldType: "int64", Key: sql.NullString{String: "PRI", Valid: true}},
+			{FieldName: "name", FieldType: "varchar(255)", GoFieldType: "null.String", Nullable: true},
+			{FieldName: "age", FieldType: "smallint", GoFieldType: "*int16", Nullable: true},
+			{FieldName: "memo", FieldType: "text", GoFieldType: "string", Nullable: true},
+		},
+	}
+	file, err := table.GenerateModelFile("dist")
+	assert.NoError(t, err)
+	fmted, err := format.Source(file.Source)
+	assert.NoError(t, err)
+	source := string(fmted)
+	assert.NotContains(t, source, "SetId(")
+	assert.Contains(t, source, "func (u *UpdateUsers) SetName(v null.String) *UpdateUsers {")
+	assert.Contains(t, source, "func (u *UpdateUsers) SetNullName() *UpdateUsers {\n\tvar v null.String\n\tu.Name = &v\n\treturn u\n}")
+	assert.Contains(t, source, "func (u *UpdateUsers) SetNullAge() *UpdateUsers {")
+	assert.NotContains(t, source, "SetNullMemo(")
+
+	table.UpdateKeys = true
+	file, err = table.GenerateModelFile("dist")
+	assert.NoError(t, err)
+	assert.Contains(t, string(file.Source), "func (u *UpdateUsers) SetId(v int64) *UpdateUsers {")
+}
+
+func TestGeneratedUpdateSetters(t *testing.T) {
+	update := model.NewUpdateFields().SetTextField("x").SetNullTextNullField()
+	assert.Equal(t, "x", *update.TextField)
+	assert.Equal(t, null.String{}, *update.TextNullField)
+	assert.Nil(t, update.IntField)
+	q, err := QueryForUpdateModel(model.NewUpdateUsers().SetName("go").SetAge(3), Where("id = ?", 1))
+	assert.NoError(t, err)
+	stmt, args, err := q.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, "UPDATE `users` SET `age` = ?,`name` = ? WHERE id = ?", stmt)
+	assert.Equal(t, []any{int64(3), "go", 1}, args)
+}
@@ -8,12 +8,18 @@ import (
 )
 
 func main() {
-	db, _ := exql.Open(&exql.OpenOptions{
+	db, err := exql.Open(&exql.OpenOptions{
 		Url: "root:@tcp(127.0.0.1:13326)/exql?charset=utf8mb4&parseTime=True&loc=Local",
 	})
-	g := exql.NewGenerator(db.DB())
-	err := g.Generate(&exql.GenerateOptions{
-		OutDir: "model",
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	g := exql.NewGeneratorWithParser(db.DB(), exql.NewInformationSchemaParser())
+	err = g.Generate(&exql.GenerateOptions{
+		OutDir:       "model",
+		Repositories: true,
+		FactoryDir:   "model/factory",
 	})
 	if err != nil {
 		log.Fatal(err)
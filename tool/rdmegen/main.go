@@ -17,6 +17,8 @@ func main() {
 		"MapRows":            catFile("example/mapper.go"),
 		"MapJoinedRows":      catFile("example/serial_mapper.go"),
 		"MapOuterJoinedRows": catFile("example/outer_join.go"),
+		"MapQualifiedRows":   catFile("example/qualified_mapper.go"),
+		"AggregateRows":      catFile("example/aggregate.go"),
 		"Tx":                 catFile("example/tx.go"),
 		"QueryBuilder":       catFile("example/query_builder.go"),
 		"AutoGenerateCode":   catFile("model/users.go"),
@@ -0,0 +1,137 @@
+package exql
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// TypeMapping overrides the Go type of the column.
+// Exactly one of Column, ColumnPattern and SQLType must be set.
+// Mappings by Column take precedence over ColumnPattern, and ColumnPattern over SQLType.
+// The first one wins among mappings of the same kind.
+//
+// Qualified types are imported automatically, e.g. "time.Duration" imports "time"
+// and "github.com/org/app/types.Status" imports "github.com/org/app/types" as types.Status.
+type TypeMapping struct {
+	// Column is the target column in the form of "table.column".
+	Column string `json:"column"`
+	// ColumnPattern is the glob pattern for column names, e.g. "*_id".
+	// It is matched against "table.column" if it contains ".", otherwise against the column name.
+	ColumnPattern string `json:"column_pattern"`
+	// SQLType is the regular expression for column types, e.g. `^int( unsigned)?$`.
+	SQLType string `json:"sql_type"`
+	// GoType is the Go type of the field, e.g. "int32".
+	GoType string `json:"go_type"`
+	// NullableGoType is the Go type of the field for nullable columns.
+	// It defaults to null.Null[GoType].
+	NullableGoType string `json:"nullable_go_type"`
+}
+
+func validateTypeMappings(mappings []TypeMapping) error {
+	for _, m := range mappings {
+		kinds := 0
+		for _, v := range []string{m.Column, m.ColumnPattern, m.SQLType} {
+			if v != "" {
+				kinds++
+			}
+		}
+		if kinds != 1 {
+			return fmt.Errorf("type mapping must have one of column, column_pattern and sql_type: %+v", m)
+		}
+		if m.GoType == "" {
+			return fmt.Errorf("type mapping must have go_type: %+v", m)
+		}
+		if table, column, ok := strings.Cut(m.Column, "."); m.Column != "" && (!ok || table == "" || column == "") {
+			return fmt.Errorf("column of type mapping must be in the form of table.column: %q", m.Column)
+		}
+		if _, err := path.Match(m.ColumnPattern, ""); err != nil {
+			return fmt.Errorf("invalid column pattern %q: %w", m.ColumnPattern, err)
+		}
+		if _, err := regexp.Compile(m.SQLType); err != nil {
+			return fmt.Errorf("invalid sql type pattern %q: %w", m.SQLType, err)
+		}
+	}
+	return nil
+}
+
+// applyTypeMappings overrides Go types of the columns. Mappings are ensured valid by validateTypeMappings.
+func applyTypeMappings(table *Table, mappings []TypeMapping) {
+	for _, col := range table.Columns {
+		m := findTypeMapping(table.TableName, col, mappings)
+		if m == nil {
+			continue
+		}
+		goType := m.GoType
+		if col.Nullable {
+			goType = m.NullableGoType
+			if goType == "" {
+				goType = fmt.Sprintf("null.Null[%s]", m.GoType)
+			}
+		}
+		col.GoFieldType, col.Imports = qualifyGoType(goType)
+	}
+}
+
+func findTypeMapping(table string, col *Column, mappings []TypeMapping) *TypeMapping {
+	for i, m := range mappings {
+		if m.Column == table+"."+col.FieldName {
+			return &mappings[i]
+		}
+	}
+	for i, m := range mappings {
+		if m.ColumnPattern == "" {
+			continue
+		}
+		name := col.FieldName
+		if strings.Contains(m.ColumnPattern, ".") {
+			name = table + "." + col.FieldName
+		}
+		if ok, _ := path.Match(m.ColumnPattern, name); ok {
+			return &mappings[i]
+		}
+	}
+	for i, m := range mappings {
+		if m.SQLType == "" {
+			continue
+		}
+		if regexp.MustCompile(m.SQLType).MatchString(col.FieldType) {
+			return &mappings[i]
+		}
+	}
+	return nil
+}
+
+// qualifiedTypePat matches the qualified type name such as "time.Duration" and "github.com/org/app/types.Status".
+var qualifiedTypePat = regexp.MustCompile(`([A-Za-z0-9_\-./~]+/)?([A-Za-z_][A-Za-z0-9_]*)\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+// qualifyGoType rewrites import paths in the type into package names
+// and returns the type and the paths to be imported.
+// The package name is assumed to be the last element of the path, except for the major version suffix.
+func qualifyGoType(goType string) (string, []string) {
+	var imports []string
+	ret := qualifiedTypePat.ReplaceAllStringFunc(goType, func(s string) string {
+		m := qualifiedTypePat.FindStringSubmatch(s)
+		dir, pkg, name := m[1], m[2], m[3]
+		importPath := dir + pkg
+		if dir != "" && regexp.MustCompile(`^v[0-9]+$`).MatchString(pkg) {
+			// github.com/org/app/v2.Type is in the package "app"
+			pkg = path.Base(strings.TrimSuffix(dir, "/"))
+		}
+		if knownPath, ok := knownImportPaths[pkg]; ok && dir == "" {
+			importPath = knownPath
+		}
+		imports = append(imports, importPath)
+		return pkg + "." + name
+	})
+	return ret, imports
+}
+
+// knownImportPaths are the packages referred without the full path in generated models.
+var knownImportPaths = map[string]string{
+	"json":    "encoding/json",
+	"sql":     "database/sql",
+	"null":    "github.com/loilo-inc/exql/v3/null",
+	"decimal": "github.com/loilo-inc/exql/v3/decimal",
+}
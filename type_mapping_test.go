@@ -0,0 +1,84 @@
+package exql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTypeMappings(t *testing.T) {
+	newTable := func() *Table {
+		return &Table{
+			TableName: "users",
+			Columns: []*Column{
+				{FieldName: "id", FieldType: "int", GoFieldType: "int64"},
+				{FieldName: "group_id", FieldType: "int", GoFieldType: "null.Int64", Nullable: true},
+				{FieldName: "status", FieldType: "enum('a','b')", GoFieldType: "string"},
+				{FieldName: "elapsed", FieldType: "time", GoFieldType: "string"},
+			},
+		}
+	}
+	t.Run("priority", func(t *testing.T) {
+		table := newTable()
+		applyTypeMappings(table, []TypeMapping{
+			{SQLType: `^int$`, GoType: "int32"},
+			{ColumnPattern: "*_id", GoType: "uint32"},
+			{Column: "users.group_id", GoType: "uint64", NullableGoType: "sql.NullInt64"},
+			{ColumnPattern: "users.stat*", GoType: "github.com/org/app/v2.Status"},
+			{ColumnPattern: "status", GoType: "string"},
+			{SQLType: `^time$`, GoType: "time.Duration"},
+		})
+		var types [][]any
+		for _, c := range table.Columns {
+			types = append(types, []any{c.GoFieldType, c.Imports})
+		}
+		assert.Equal(t, [][]any{
+			{"int32", []string(nil)},
+			{"sql.NullInt64", []string{"database/sql"}},
+			{"app.Status", []string{"github.com/org/app/v2"}},
+			{"time.Duration", []string{"time"}},
+		}, types)
+	})
+	t.Run("nullable columns default to null.Null", func(t *testing.T) {
+		table := newTable()
+		applyTypeMappings(table, []TypeMapping{{ColumnPattern: "*_id", GoType: "github.com/org/app/ids.GroupID"}})
+		col := table.Columns[1]
+		assert.Equal(t, "null.Null[ids.GroupID]", col.GoFieldType)
+		assert.Equal(t, []string{"github.com/loilo-inc/exql/v3/null", "github.com/org/app/ids"}, col.Imports)
+	})
+	t.Run("generated file imports packages", func(t *testing.T) {
+		table := newTable()
+		applyTypeMappings(table, []TypeMapping{
+			{Column: "users.elapsed", GoType: "time.Duration"},
+			{Column: "users.id", GoType: "json.Number"},
+			{Column: "users.group_id", GoType: "int64", NullableGoType: "*int64"},
+		})
+		file, err := table.GenerateModelFile("model")
+		assert.NoError(t, err)
+		source := string(file.Source)
+		assert.Contains(t, source, `import "time"`)
+		assert.Contains(t, source, `import "encoding/json"`)
+		assert.NotContains(t, source, `exql/v3/null"`)
+	})
+}
+
+func TestValidateTypeMappings(t *testing.T) {
+	assert.NoError(t, validateTypeMappings([]TypeMapping{
+		{Column: "users.id", GoType: "int32"},
+		{ColumnPattern: "*_at", GoType: "time.Time"},
+		{SQLType: `^int\b`, GoType: "int32"},
+	}))
+	for name, m := range map[string]TypeMapping{
+		"no target":       {GoType: "int32"},
+		"multiple target": {Column: "users.id", SQLType: "int", GoType: "int32"},
+		"no go type":      {Column: "users.id"},
+		"unqualified":     {Column: "age", GoType: "int32"},
+		"no column name":  {Column: "users.", GoType: "int32"},
+		"invalid glob":    {ColumnPattern: "[", GoType: "int32"},
+		"invalid regexp":  {SQLType: "(", GoType: "int32"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, validateTypeMappings([]TypeMapping{m}))
+		})
+	}
+}